@@ -0,0 +1,282 @@
+package linq
+
+import (
+	"errors"
+	"math"
+	"math/big"
+)
+
+// Frequencies counts the occurrences of each element of loop.
+func Frequencies[T comparable](loop Enumerator[T]) map[T]int {
+	return FrequenciesBy(func(element T) T { return element }, loop)
+}
+
+// FrequenciesBy counts the occurrences of each key which keySelector
+// extracts from the elements of loop.
+func FrequenciesBy[T any, K comparable](keySelector func(T) K,
+	loop Enumerator[T]) map[K]int {
+	result := make(map[K]int)
+	loop(func(element T) {
+		result[keySelector(element)]++
+	})
+	return result
+}
+
+// Mode returns the most frequent elements of loop in the order of their
+// first appearance.
+// It returns an empty slice if loop is empty.
+func Mode[T comparable](loop Enumerator[T]) []T {
+	var order []T
+	counts := make(map[T]int)
+	max := 0
+	loop(func(element T) {
+		n := counts[element] + 1
+		if n == 1 {
+			order = append(order, element)
+		}
+		counts[element] = n
+		if n > max {
+			max = n
+		}
+	})
+	result := []T{}
+	for _, element := range order {
+		if counts[element] == max {
+			result = append(result, element)
+		}
+	}
+	return result
+}
+
+// ApproxPercentile estimates the q-quantile (0 <= q <= 1) of loop with the
+// P-square algorithm by R. Jain and I. Chlamtac without storing the elements.
+// Thus it works in O(1) space even for a very long sequence.
+// It returns NaN if loop is empty.
+// It panics if q is out of [0, 1].
+func ApproxPercentile(q float64, loop Enumerator[float64]) float64 {
+	if !(0 <= q && q <= 1) {
+		panic("linq: quantile out of [0, 1]")
+	}
+	p := newP2(q)
+	loop(p.add)
+	return p.value()
+}
+
+// p2 represents the state of the P-square algorithm.
+type p2 struct {
+	count   int
+	heights [5]float64 // the heights of the markers
+	pos     [5]float64 // the actual positions of the markers
+	desired [5]float64 // the desired positions of the markers
+	incr    [5]float64 // the increments of the desired positions
+}
+
+func newP2(q float64) *p2 {
+	return &p2{
+		desired: [5]float64{1, 1 + 2*q, 1 + 4*q, 3 + 2*q, 5},
+		incr:    [5]float64{0, q / 2, q, (1 + q) / 2, 1},
+		pos:     [5]float64{1, 2, 3, 4, 5},
+	}
+}
+
+func (p *p2) add(x float64) {
+	h := &p.heights
+	if p.count < 5 {
+		// Insert x into the sorted initial observations.
+		i := p.count
+		for ; i > 0 && h[i-1] > x; i-- {
+			h[i] = h[i-1]
+		}
+		h[i] = x
+		p.count++
+		return
+	}
+	p.count++
+	var k int
+	switch {
+	case x < h[0]:
+		h[0] = x
+		k = 0
+	case x >= h[4]:
+		h[4] = x
+		k = 3
+	default:
+		for k = 0; x >= h[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		p.pos[i]++
+	}
+	for i := 0; i < 5; i++ {
+		p.desired[i] += p.incr[i]
+	}
+	n := &p.pos
+	for i := 1; i <= 3; i++ {
+		d := p.desired[i] - n[i]
+		if (d >= 1 && n[i+1]-n[i] > 1) || (d <= -1 && n[i-1]-n[i] < -1) {
+			s := 1.0
+			if d < 0 {
+				s = -1.0
+			}
+			// Try the piecewise-parabolic prediction first.
+			y := h[i] + s/(n[i+1]-n[i-1])*
+				((n[i]-n[i-1]+s)*(h[i+1]-h[i])/(n[i+1]-n[i])+
+					(n[i+1]-n[i]-s)*(h[i]-h[i-1])/(n[i]-n[i-1]))
+			if !(h[i-1] < y && y < h[i+1]) {
+				j := i + int(s)
+				y = h[i] + s*(h[j]-h[i])/(n[j]-n[i])
+			}
+			h[i] = y
+			n[i] += s
+		}
+	}
+}
+
+func (p *p2) value() float64 {
+	switch {
+	case p.count == 0:
+		return math.NaN()
+	case p.count <= 5:
+		// Interpolate the sorted observations exactly.
+		q := p.incr[2]
+		r := q * float64(p.count-1)
+		i := int(r)
+		if i+1 >= p.count {
+			return p.heights[p.count-1]
+		}
+		return p.heights[i] + (r-float64(i))*(p.heights[i+1]-p.heights[i])
+	default:
+		return p.heights[2]
+	}
+}
+
+// Sum returns the sum of the elements of loop.
+// It returns 0 if loop is empty.
+func Sum[T Number](loop Enumerator[T]) T {
+	var sum T
+	loop(func(element T) {
+		sum += element
+	})
+	return sum
+}
+
+// ErrOverflow is the error which SumChecked returns on integer overflow.
+var ErrOverflow = errors.New("linq: integer overflow")
+
+// SumChecked returns the sum of the elements of loop.
+// If the sum overflows, it stops the enumeration and returns ErrOverflow.
+func SumChecked[T Integer](loop Enumerator[T]) (T, error) {
+	var sum T
+	var err error
+	loop.LoopWithExit(func(element T, exit func()) {
+		s := sum + element
+		if (element > 0 && s < sum) || (element < 0 && s > sum) {
+			err = ErrOverflow
+			exit()
+		}
+		sum = s
+	})
+	if err != nil {
+		return 0, err
+	}
+	return sum, nil
+}
+
+// SumBig returns the sum of the elements of loop as a big.Int, which never
+// overflows.
+func SumBig(loop Enumerator[int64]) *big.Int {
+	sum := new(big.Int)
+	x := new(big.Int)
+	loop(func(element int64) {
+		sum.Add(sum, x.SetInt64(element))
+	})
+	return sum
+}
+
+// SumBigFloat returns the sum of the elements of loop as a big.Float with
+// the given precision in bits.
+// If prec is 0, it is set to 53, the precision of float64, at the first
+// addition; see big.Float.
+// It panics with big.ErrNaN if the sum of infinities of opposite signs is
+// taken.
+// NaN elements are not allowed for the same reason.
+func SumBigFloat(prec uint, loop Enumerator[float64]) *big.Float {
+	sum := new(big.Float).SetPrec(prec)
+	x := new(big.Float)
+	loop(func(element float64) {
+		sum.Add(sum, x.SetFloat64(element))
+	})
+	return sum
+}
+
+// SumFloat returns the sum of the elements of loop with Neumaier's variant
+// of Kahan compensated summation.
+// It is much more accurate than Sum for a long sequence.
+func SumFloat(loop Enumerator[float64]) float64 {
+	sum, _ := sumFloat(loop)
+	return sum
+}
+
+// AverageFloat returns the average of the elements of loop, which
+// are summed as SumFloat does.
+// It returns NaN if loop is empty.
+func AverageFloat(loop Enumerator[float64]) float64 {
+	sum, n := sumFloat(loop)
+	if n == 0 {
+		return math.NaN()
+	}
+	return sum / float64(n)
+}
+
+// sumFloat returns the compensated sum of loop and the number of elements.
+func sumFloat(loop Enumerator[float64]) (float64, int) {
+	sum, c := 0.0, 0.0 // c is the compensation for lost low-order bits.
+	n := 0
+	loop(func(x float64) {
+		t := sum + x
+		if math.Abs(sum) >= math.Abs(x) {
+			c += (sum - t) + x
+		} else {
+			c += (x - t) + sum
+		}
+		sum = t
+		n++
+	})
+	return sum + c, n
+}
+
+// MinFunc returns the least element of the sequence according to less.
+// If there are several least elements, it returns the first one.
+// It returns false as the second value if the sequence is empty.
+func (loop Enumerator[T]) MinFunc(less func(a, b T) bool) (T, bool) {
+	return loop.Reduce(func(min, element T) T {
+		if less(element, min) {
+			return element
+		}
+		return min
+	})
+}
+
+// MaxFunc returns the greatest element of the sequence according to less.
+// If there are several greatest elements, it returns the first one.
+// It returns false as the second value if the sequence is empty.
+func (loop Enumerator[T]) MaxFunc(less func(a, b T) bool) (T, bool) {
+	return loop.Reduce(func(max, element T) T {
+		if less(max, element) {
+			return element
+		}
+		return max
+	})
+}
+
+// Min returns the least element of loop.
+// It returns false as the second value if loop is empty.
+func Min[T Ordered](loop Enumerator[T]) (T, bool) {
+	return loop.MinFunc(func(a, b T) bool { return a < b })
+}
+
+// Max returns the greatest element of loop.
+// It returns false as the second value if loop is empty.
+func Max[T Ordered](loop Enumerator[T]) (T, bool) {
+	return loop.MaxFunc(func(a, b T) bool { return a < b })
+}
@@ -0,0 +1,159 @@
+package linq
+
+import (
+	. "fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+func ExampleFrequencies() {
+	x := Frequencies(FromString("banana"))
+	Println(x['a'], x['b'], x['n'], x['z'])
+	// Output:
+	// 3 1 2 0
+}
+
+func ExampleFrequenciesBy() {
+	words := From(strings.Fields("apple avocado banana blueberry cherry"))
+	x := FrequenciesBy(func(s string) byte { return s[0] }, words)
+	Printf("%v\n", x)
+	// Output:
+	// map[97:2 98:2 99:1]
+}
+
+func ExampleMode() {
+	x := Mode(From([]int{3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5}))
+	Printf("%v\n", x)
+
+	y := Mode(From([]string{"to", "be", "or", "not", "to", "be"}))
+	Printf("%v\n", y)
+
+	z := Mode(Empty[int]())
+	Printf("%v\n", z)
+	// Output:
+	// [5]
+	// [to be]
+	// []
+}
+
+func ExampleApproxPercentile() {
+	seq := Select(func(i int) float64 { return float64(i) }, Range(1, 10000))
+	median := ApproxPercentile(0.5, seq)
+	p99 := ApproxPercentile(0.99, seq)
+	Printf("%.0f %.0f\n", median, p99)
+
+	few := From([]float64{4, 1, 3, 2})
+	Println(ApproxPercentile(0.5, few))
+	Println(ApproxPercentile(0.5, Empty[float64]()))
+
+	for _, q := range []float64{1.5, -0.1} {
+		func() {
+			defer func() { Println(recover()) }()
+			ApproxPercentile(q, few)
+		}()
+	}
+	// Output:
+	// 5000 9900
+	// 2.5
+	// NaN
+	// linq: quantile out of [0, 1]
+	// linq: quantile out of [0, 1]
+}
+
+func ExampleSum() {
+	Println(Sum(Range(1, 100)))
+	Println(Sum(From([]float64{0.5, 0.25, 0.125})))
+	// Output:
+	// 5050
+	// 0.875
+}
+
+func ExampleSumChecked() {
+	x, err := SumChecked(From([]int8{100, 20, 7}))
+	Println(x, err)
+
+	y, err := SumChecked(From([]int8{100, 20, 8}))
+	Println(y, err)
+
+	z, err := SumChecked(From([]uint{math.MaxUint, 1}))
+	Println(z, err)
+	// Output:
+	// 127 <nil>
+	// 0 linq: integer overflow
+	// 0 linq: integer overflow
+}
+
+func ExampleSumBig() {
+	x := SumBig(Repeat(int64(math.MaxInt64), 3))
+	Println(x)
+	// Output:
+	// 27670116110564327421
+}
+
+func ExampleSumBigFloat() {
+	seq := From([]float64{1e100, 1, -1e100})
+	Println(Sum(seq))
+	Println(SumBigFloat(1000, seq).Text('g', 10))
+	// Output:
+	// 0
+	// 1
+}
+
+func ExampleSumFloat() {
+	seq := Repeat(0.1, 10000000)
+	Println(Sum(seq))
+	Println(SumFloat(seq))
+
+	Println(SumFloat(From([]float64{1, 1e100, 1, -1e100})))
+	// Output:
+	// 999999.9998389754
+	// 1e+06
+	// 2
+}
+
+func ExampleAverageFloat() {
+	Println(AverageFloat(From([]float64{1, 2, 3, 4})))
+	Println(AverageFloat(Empty[float64]()))
+	// Output:
+	// 2.5
+	// NaN
+}
+
+func ExampleEnumerator_MinFunc() {
+	t0 := time.Date(2022, 3, 25, 0, 0, 0, 0, time.UTC)
+	times := From([]time.Time{t0.Add(time.Hour), t0, t0.Add(time.Minute)})
+	x, ok := times.MinFunc(time.Time.Before)
+	Println(x, ok)
+
+	_, ok = Empty[time.Time]().MinFunc(time.Time.Before)
+	Println(ok)
+	// Output:
+	// 2022-03-25 00:00:00 +0000 UTC true
+	// false
+}
+
+func ExampleEnumerator_MaxFunc() {
+	type version struct{ major, minor int }
+	versions := From([]version{{1, 18}, {1, 2}, {1, 20}, {0, 99}})
+	x, ok := versions.MaxFunc(func(a, b version) bool {
+		return a.major < b.major || (a.major == b.major && a.minor < b.minor)
+	})
+	Println(x, ok)
+	// Output:
+	// {1 20} true
+}
+
+func ExampleMin() {
+	Println(Min(From([]int{3, 1, 4, 1, 5})))
+	Println(Min(Empty[string]()))
+	// Output:
+	// 1 true
+	//  false
+}
+
+func ExampleMax() {
+	Println(Max(From([]string{"kiwi", "apple", "mango"})))
+	// Output:
+	// mango true
+}
@@ -0,0 +1,59 @@
+package linq
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"io"
+)
+
+// TarEntry is an entry of a tar archive.
+type TarEntry struct {
+	Header *tar.Header
+	r      *tar.Reader
+}
+
+// Open returns a reader of the content of e.
+// It is valid only until the next entry is yielded, since a tar archive
+// is read sequentially; the content is skipped unless it is read.
+func (e TarEntry) Open() io.Reader {
+	return e.r
+}
+
+// FromTar creates an Enumerator which yields each entry of the tar archive
+// read from r.
+// The enumerator reads r as a stream, so it can be enumerated only once
+// unless r is rewound.
+// It may panic with the error from r other than io.EOF.
+func FromTar(r io.Reader) Enumerator[TarEntry] {
+	return func(yield func(TarEntry)) {
+		tr := tar.NewReader(r)
+		for {
+			h, err := tr.Next()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				panic(err)
+			}
+			yield(TarEntry{h, tr})
+		}
+	}
+}
+
+// ZipEntry is an entry of a zip archive.
+// Its header and Open method are those of zip.File.
+type ZipEntry struct {
+	*zip.File
+}
+
+// FromZip creates an Enumerator which yields each entry of zr in the order
+// of its central directory.
+// The content of each entry is opened only when its Open method is called,
+// and may be read at any time; close it after use.
+func FromZip(zr *zip.Reader) Enumerator[ZipEntry] {
+	return func(yield func(ZipEntry)) {
+		for _, f := range zr.File {
+			yield(ZipEntry{f})
+		}
+	}
+}
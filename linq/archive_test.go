@@ -0,0 +1,66 @@
+package linq
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	. "fmt"
+	"io"
+	"path"
+)
+
+var archiveFiles = []struct{ name, body string }{
+	{"readme.txt", "hello"},
+	{"src/main.go", "package main\n\nfunc main() {}\n"},
+	{"src/util.go", "package main\n"},
+}
+
+func ExampleFromTar() {
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, f := range archiveFiles {
+		tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0o644,
+			Size: int64(len(f.body))})
+		io.WriteString(tw, f.body)
+	}
+	tw.Close()
+
+	goFiles := FromTar(&buf).Where(func(e TarEntry) bool {
+		return path.Ext(e.Header.Name) == ".go"
+	})
+	goFiles(func(e TarEntry) {
+		b, _ := io.ReadAll(e.Open())
+		Printf("%s %q\n", e.Header.Name, b[:12])
+	})
+	// Output:
+	// src/main.go "package main"
+	// src/util.go "package main"
+}
+
+func ExampleFromZip() {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range archiveFiles {
+		w, _ := zw.Create(f.name)
+		io.WriteString(w, f.body)
+	}
+	zw.Close()
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		Println(err)
+		return
+	}
+
+	largest := FromZip(zr).OrderBy(func(a, b ZipEntry) bool {
+		return a.UncompressedSize64 > b.UncompressedSize64
+	}).First().OrElse(ZipEntry{})
+	Println(largest.Name, largest.UncompressedSize64)
+
+	rc, _ := largest.Open()
+	defer rc.Close()
+	b, _ := io.ReadAll(rc)
+	Printf("%q\n", b)
+	// Output:
+	// src/main.go 29
+	// "package main\n\nfunc main() {}\n"
+}
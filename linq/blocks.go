@@ -0,0 +1,91 @@
+package linq
+
+import (
+	"bytes"
+	"io"
+)
+
+// FromReaderBlocks creates an Enumerator[[]byte] which reads r in blocks
+// of blockSize bytes.
+// Each block is blockSize long except possibly the last one, and is newly
+// allocated so that the caller may keep it.
+// The enumerator may panic with the error from r other than io.EOF.
+func FromReaderBlocks(r io.Reader, blockSize int) Enumerator[[]byte] {
+	return func(yield func([]byte)) {
+		readBlocks(r, blockSize, false, yield)
+	}
+}
+
+// FromReaderBlocksShared is a variant of FromReaderBlocks which reuses a
+// single buffer for all the blocks.
+// Each block is valid only until the next block is yielded; copy it to
+// keep it.
+// It allocates nothing per block.
+func FromReaderBlocksShared(r io.Reader, blockSize int) Enumerator[[]byte] {
+	return func(yield func([]byte)) {
+		readBlocks(r, blockSize, true, yield)
+	}
+}
+
+func readBlocks(r io.Reader, blockSize int, shared bool,
+	yield func([]byte)) {
+	if blockSize < 1 {
+		panic("linq: non-positive block size")
+	}
+	var buf []byte
+	for {
+		if buf == nil || !shared {
+			buf = make([]byte, blockSize)
+		}
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			yield(buf[:n:n])
+		}
+		switch err {
+		case nil:
+		case io.EOF, io.ErrUnexpectedEOF:
+			return
+		default:
+			panic(err)
+		}
+	}
+}
+
+// ToBuffer creates a bytes.Buffer holding the concatenation of the blocks
+// of loop.
+// The blocks are copied, so it works with FromReaderBlocksShared.
+func ToBuffer(loop Enumerator[[]byte]) *bytes.Buffer {
+	var buf bytes.Buffer
+	loop(func(block []byte) {
+		buf.Write(block)
+	})
+	return &buf
+}
+
+// CopyTo writes the blocks of loop to w and returns the number of bytes
+// written.
+// It stops at the first error from w and returns it.
+// If loop panics with an error value, e.g. one from the reader of
+// FromReaderBlocks, it recovers from the panic and returns the error.
+// Panics raised by w are not recovered.
+func CopyTo(w io.Writer, loop Enumerator[[]byte]) (written int64,
+	err error) {
+	var werr error
+	stoppable := func(yield func([]byte)) {
+		loop.LoopWithExit(func(block []byte, exit func()) {
+			yield(block)
+			if werr != nil {
+				exit()
+			}
+		})
+	}
+	err = tryLoop(stoppable, func(block []byte) {
+		n, e := w.Write(block)
+		written += int64(n)
+		werr = e
+	})
+	if werr != nil {
+		err = werr
+	}
+	return
+}
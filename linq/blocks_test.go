@@ -0,0 +1,79 @@
+package linq
+
+import (
+	"bytes"
+	"errors"
+	. "fmt"
+	"io"
+	"runtime"
+	"strings"
+	"testing/iotest"
+)
+
+func ExampleFromReaderBlocks() {
+	r := strings.NewReader("abcdefghij")
+	blocks := FromReaderBlocks(r, 4).ToSlice()
+	Printf("%q\n", blocks)
+	// Output:
+	// ["abcd" "efgh" "ij"]
+}
+
+func ExampleFromReaderBlocksShared() {
+	r := bytes.NewReader([]byte{1, 2, 3, 4, 5, 6, 7})
+	sums := Select(func(b []byte) int {
+		return int(Sum(FromBytes(b)))
+	}, FromReaderBlocksShared(r, 3))
+	Printf("%v\n", sums.ToSlice())
+	// Output:
+	// [6 15 7]
+}
+
+func ExampleToBuffer() {
+	r := strings.NewReader("abcdefghij")
+	buf := ToBuffer(FromReaderBlocksShared(r, 4))
+	Println(buf.String(), buf.Len())
+	// Output:
+	// abcdefghij 10
+}
+
+// limitedWriter fails after n bytes.
+type limitedWriter struct {
+	n int
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		n := w.n
+		w.n = 0
+		return n, io.ErrShortWrite
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func ExampleCopyTo() {
+	var buf bytes.Buffer
+	n, err := CopyTo(&buf, FromReaderBlocks(strings.NewReader("abcdefg"), 3))
+	Println(n, err, buf.String())
+
+	n, err = CopyTo(&limitedWriter{5},
+		FromReaderBlocks(strings.NewReader("abcdefg"), 3))
+	Println(n, err)
+
+	r := io.MultiReader(strings.NewReader("abcd"),
+		iotest.ErrReader(errors.New("broken")))
+	n, err = CopyTo(io.Discard, FromReaderBlocks(r, 3))
+	Println(n, err)
+
+	// A panic of the writer, e.g. a bug, propagates.
+	defer func() {
+		_, ok := recover().(runtime.Error)
+		Println(ok)
+	}()
+	CopyTo((*bytes.Buffer)(nil), FromReaderBlocks(strings.NewReader("a"), 3))
+	// Output:
+	// 7 <nil> abcdefg
+	// 5 short write
+	// 4 broken
+	// true
+}
@@ -0,0 +1,79 @@
+package linq
+
+// Overflow is the policy of ToChanWith for an element which the channel
+// has no room for, i.e. when the consumer is slower than loop.
+type Overflow int
+
+const (
+	// OverflowBlock blocks the enumeration until the consumer receives;
+	// it stalls the upstream of loop, e.g. a hardware or network reader.
+	OverflowBlock Overflow = iota
+
+	// OverflowDropOldest drops the oldest element in the channel to make
+	// room for the new one.
+	OverflowDropOldest
+
+	// OverflowDropNewest drops the new element.
+	OverflowDropNewest
+
+	// OverflowStop stops the enumeration and closes the channel.
+	OverflowStop
+)
+
+// ToChan creates a channel with a buffer of size elements, to which a new
+// goroutine sends each element of loop, and closes it after loop runs out.
+// The goroutine blocks while the buffer is full; see ToChanWith for the
+// other policies.
+// It remains until loop runs out, unless the channel is drained.
+// If loop may panic, send Materialize(loop) to deliver the error to the
+// consumer; otherwise the panic crashes the program.
+func (loop Enumerator[T]) ToChan(size int) <-chan T {
+	return loop.ToChanWith(size, OverflowBlock, nil)
+}
+
+// ToChanWith is a variant of ToChan which handles an element that the
+// buffer has no room for by policy.
+// onOverflow, if not nil, is called with each dropped element, or with the
+// element at which the enumeration stops for OverflowStop.
+// If size is 0, OverflowDropOldest behaves as OverflowDropNewest, since
+// the channel holds no element to drop.
+func (loop Enumerator[T]) ToChanWith(size int, policy Overflow,
+	onOverflow func(T)) <-chan T {
+	c := make(chan T, size)
+	overflow := func(element T) {
+		if onOverflow != nil {
+			onOverflow(element)
+		}
+	}
+	go func() {
+		defer close(c)
+		loop.LoopWithExit(func(element T, exit func()) {
+			if policy == OverflowBlock {
+				c <- element
+				return
+			}
+			for {
+				select {
+				case c <- element:
+					return
+				default:
+				}
+				switch {
+				case policy == OverflowDropOldest && size > 0:
+					select {
+					case old := <-c:
+						overflow(old)
+					default: // The consumer has just received one.
+					}
+				case policy == OverflowStop:
+					overflow(element)
+					exit()
+				default:
+					overflow(element)
+					return
+				}
+			}
+		})
+	}()
+	return c
+}
@@ -0,0 +1,39 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+func ExampleEnumerator_ToChan() {
+	c := Range(1, 5).ToChan(2)
+	for i := range c {
+		Print(i, "-")
+	}
+	Println()
+	// Output:
+	// 1-2-3-4-5-
+}
+
+func ExampleEnumerator_ToChanWith() {
+	// The consumer starts after all the elements have been sent.
+	run := func(policy Overflow) {
+		var dropped []int
+		done := make(chan bool)
+		loop := Enumerator[int](func(yield func(int)) {
+			defer close(done)
+			Range(1, 6)(yield)
+		})
+		c := loop.ToChanWith(3, policy, func(i int) {
+			dropped = append(dropped, i)
+		})
+		<-done
+		Println(FromChan(c).ToSlice(), dropped)
+	}
+	run(OverflowDropOldest)
+	run(OverflowDropNewest)
+	run(OverflowStop)
+	// Output:
+	// [4 5 6] [1 2 3]
+	// [1 2 3] [4 5 6]
+	// [1 2 3] [4]
+}
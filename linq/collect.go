@@ -0,0 +1,116 @@
+package linq
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ElementError is an error about an element of a sequence.
+type ElementError[T any] struct {
+	Index   int // the index of the element, counting from 0
+	Element T
+	Err     error
+}
+
+// Error returns a string such as "element 2 (x): invalid syntax".
+func (e *ElementError[T]) Error() string {
+	return fmt.Sprintf("element %d (%v): %v", e.Index, e.Element, e.Err)
+}
+
+// Unwrap returns e.Err.
+func (e *ElementError[T]) Unwrap() error {
+	return e.Err
+}
+
+// ErrorCollector collects the errors which operators such as SelectParse
+// skip instead of panicking.
+// It is safe for concurrent use.
+// The zero value is an empty collector.
+type ErrorCollector struct {
+	mu     sync.Mutex
+	errors []error
+}
+
+// Add adds err to c.
+func (c *ErrorCollector) Add(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errors = append(c.errors, err)
+}
+
+// Errors returns the errors collected so far, in the order of addition.
+func (c *ErrorCollector) Errors() []error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]error(nil), c.errors...)
+}
+
+// Len returns the number of the errors collected so far.
+func (c *ErrorCollector) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.errors)
+}
+
+// Reset discards the errors collected so far.
+func (c *ErrorCollector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.errors = nil
+}
+
+// SelectParse creates an Enumerator which yields parse(element) for each
+// element of loop, e.g. to parse strings into numbers or dates.
+// The elements for which parse fails are skipped and their errors are
+// added to the returned ErrorCollector as *ElementError[T].
+// Each enumeration adds its own errors; call Reset to start afresh.
+func SelectParse[T any, R any](parse func(T) (R, error),
+	loop Enumerator[T]) (Enumerator[R], *ErrorCollector) {
+	c := new(ErrorCollector)
+	return func(yield func(R)) {
+		i := 0
+		loop(func(element T) {
+			r, err := parse(element)
+			if err != nil {
+				c.Add(&ElementError[T]{i, element, err})
+			} else {
+				yield(r)
+			}
+			i++
+		})
+	}, c
+}
+
+// Validate creates an Enumerator which yields the elements of the sequence
+// that satisfy all of rules, i.e. for which every rule returns nil.
+// Each violation of a rule is added to c as *ElementError[T], so an
+// invalid element may add several errors.
+// See ValidateFunc to handle the violations otherwise.
+func (loop Enumerator[T]) Validate(c *ErrorCollector,
+	rules ...func(T) error) Enumerator[T] {
+	return loop.ValidateFunc(func(e *ElementError[T]) {
+		c.Add(e)
+	}, rules...)
+}
+
+// ValidateFunc is a variant of Validate which calls onViolation for each
+// violation, e.g. to send it to a channel or a log.
+func (loop Enumerator[T]) ValidateFunc(onViolation func(*ElementError[T]),
+	rules ...func(T) error) Enumerator[T] {
+	return func(yield func(T)) {
+		i := 0
+		loop(func(element T) {
+			valid := true
+			for _, rule := range rules {
+				if err := rule(element); err != nil {
+					onViolation(&ElementError[T]{i, element, err})
+					valid = false
+				}
+			}
+			if valid {
+				yield(element)
+			}
+			i++
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package linq
+
+import (
+	"errors"
+	. "fmt"
+	"strconv"
+	"time"
+)
+
+func ExampleSelectParse() {
+	input := From([]string{"1", "2", "three", "4", "5x"})
+	numbers, errs := SelectParse(strconv.Atoi, input)
+	Println(numbers.ToSlice(), Sum(numbers))
+	for _, err := range errs.Errors()[:2] {
+		Println(err)
+	}
+	// Both ToSlice and Sum above added the two errors.
+	Println(errs.Len())
+
+	// The errors can be examined with errors.As and errors.Is.
+	var e *ElementError[string]
+	if errors.As(errs.Errors()[0], &e) {
+		Println(e.Index, e.Element, errors.Is(e, strconv.ErrSyntax))
+	}
+	// Output:
+	// [1 2 4] 7
+	// element 2 (three): strconv.Atoi: parsing "three": invalid syntax
+	// element 4 (5x): strconv.Atoi: parsing "5x": invalid syntax
+	// 4
+	// 2 three true
+}
+
+func ExampleErrorCollector() {
+	dates, errs := SelectParse(func(s string) (time.Time, error) {
+		return time.Parse("2006-01-02", s)
+	}, From([]string{"2024-02-29", "2023-02-29", "2024-03-01"}))
+	dates(func(t time.Time) {
+		Println(t.Weekday())
+	})
+	Println(errs.Len())
+	errs.Reset()
+	Println(errs.Len())
+	// Output:
+	// Thursday
+	// Friday
+	// 1
+	// 0
+}
+
+type validateRecord struct {
+	Name string
+	Age  int
+}
+
+func ExampleEnumerator_Validate() {
+	nameRequired := func(r validateRecord) error {
+		if r.Name == "" {
+			return errors.New("name is required")
+		}
+		return nil
+	}
+	ageInRange := func(r validateRecord) error {
+		if r.Age < 0 || r.Age > 150 {
+			return Errorf("age %d is out of range", r.Age)
+		}
+		return nil
+	}
+	var errs ErrorCollector
+	records := From([]validateRecord{
+		{"alice", 30}, {"", 20}, {"bob", -1}, {"", 200}, {"carol", 150},
+	}).Validate(&errs, nameRequired, ageInRange)
+	Println(records.ToSlice())
+	for _, err := range errs.Errors() {
+		Println(err)
+	}
+	// Output:
+	// [{alice 30} {carol 150}]
+	// element 1 ({ 20}): name is required
+	// element 2 ({bob -1}): age -1 is out of range
+	// element 3 ({ 200}): name is required
+	// element 3 ({ 200}): age 200 is out of range
+}
+
+func ExampleEnumerator_ValidateFunc() {
+	violations := make(chan *ElementError[int], 10)
+	positive := Range(-2, 5).ValidateFunc(func(e *ElementError[int]) {
+		violations <- e
+	}, func(x int) error {
+		if x <= 0 {
+			return errors.New("not positive")
+		}
+		return nil
+	})
+	Println(positive.ToSlice())
+	close(violations)
+	for e := range violations {
+		Println(e.Element, e.Err)
+	}
+	// Output:
+	// [1 2]
+	// -2 not positive
+	// -1 not positive
+	// 0 not positive
+}
@@ -0,0 +1,137 @@
+package linq
+
+// StartsWithFunc reports whether the sequence starts with the elements
+// of prefix, comparing each pair of elements with equal.
+// It buffers prefix and stops enumerating the sequence at the first
+// mismatch.
+func (loop Enumerator[T]) StartsWithFunc(prefix Enumerator[T],
+	equal func(a, b T) bool) bool {
+	p := prefix.ToSlice()
+	if len(p) == 0 {
+		return true
+	}
+	i := 0
+	loop.LoopWithExit(func(element T, exit func()) {
+		if !equal(element, p[i]) {
+			exit()
+			return
+		}
+		i++
+		if i == len(p) {
+			exit()
+		}
+	})
+	return i == len(p)
+}
+
+// EndsWithFunc reports whether the sequence ends with the elements of
+// suffix, comparing each pair of elements with equal.
+// It buffers suffix and as many last elements of the sequence.
+func (loop Enumerator[T]) EndsWithFunc(suffix Enumerator[T],
+	equal func(a, b T) bool) bool {
+	s := suffix.ToSlice()
+	if len(s) == 0 {
+		return true
+	}
+	last := make([]T, len(s)) // a ring buffer of the last elements
+	n := 0
+	loop(func(element T) {
+		last[n%len(s)] = element
+		n++
+	})
+	if n < len(s) {
+		return false
+	}
+	for i, e := range s {
+		if !equal(last[(n+i)%len(s)], e) {
+			return false
+		}
+	}
+	return true
+}
+
+// StartsWith reports whether loop starts with the elements of prefix.
+func StartsWith[T comparable](loop, prefix Enumerator[T]) bool {
+	return loop.StartsWithFunc(prefix, equal[T])
+}
+
+// EndsWith reports whether loop ends with the elements of suffix.
+func EndsWith[T comparable](loop, suffix Enumerator[T]) bool {
+	return loop.EndsWithFunc(suffix, equal[T])
+}
+
+func equal[T comparable](a, b T) bool {
+	return a == b
+}
+
+// CompareFunc compares loop1 and loop2 lexicographically with cmp, which
+// returns a negative number, 0 or a positive number when a < b, a == b or
+// a > b respectively.
+// It enumerates loop1 and loop2 in step and stops at the first pair of
+// elements for which cmp returns non-zero, returning the value.
+// If one sequence is a prefix of the other, the shorter one is less.
+func CompareFunc[T any](cmp func(a, b T) int,
+	loop1, loop2 Enumerator[T]) int {
+	next, stop := pull(loop2)
+	defer stop()
+
+	result := 0
+	done := false
+	loop1.LoopWithExit(func(element T, exit func()) {
+		element2, ok := next()
+		if !ok { // run out of loop2
+			result = 1
+		} else {
+			result = cmp(element, element2)
+		}
+		if result != 0 {
+			done = true
+			exit()
+		}
+	})
+	if !done { // run out of loop1; see whether loop2 has more elements.
+		if _, ok := next(); ok {
+			result = -1
+		}
+	}
+	return result
+}
+
+// Compare compares loop1 and loop2 lexicographically and returns -1, 0 or
+// +1 if loop1 < loop2, loop1 == loop2 or loop1 > loop2 respectively.
+// It stops at the first pair of elements which differ.
+func Compare[T Ordered](loop1, loop2 Enumerator[T]) int {
+	return CompareFunc(func(a, b T) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	}, loop1, loop2)
+}
+
+// IsSortedBy reports whether the sequence is sorted in ascending order
+// according to less, i.e. no element is less than its predecessor.
+// It stops at the first pair of elements out of order.
+func (loop Enumerator[T]) IsSortedBy(less func(a, b T) bool) bool {
+	sorted := true
+	var prev T
+	first := true
+	loop.LoopWithExit(func(element T, exit func()) {
+		if !first && less(element, prev) {
+			sorted = false
+			exit()
+		}
+		prev, first = element, false
+	})
+	return sorted
+}
+
+// IsSorted reports whether loop is sorted in ascending order.
+// It stops at the first pair of elements out of order.
+func IsSorted[T Ordered](loop Enumerator[T]) bool {
+	return loop.IsSortedBy(func(a, b T) bool { return a < b })
+}
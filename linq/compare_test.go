@@ -0,0 +1,96 @@
+package linq
+
+import (
+	. "fmt"
+	"strings"
+)
+
+func ExampleEnumerator_StartsWithFunc() {
+	words := From([]string{"GET", "/index.html", "HTTP/1.1"})
+	prefix := From([]string{"get"})
+	Println(words.StartsWithFunc(prefix, strings.EqualFold))
+	// Output:
+	// true
+}
+
+func ExampleEnumerator_EndsWithFunc() {
+	words := From([]string{"a", "B", "c"})
+	suffix := From([]string{"b", "C"})
+	Println(words.EndsWithFunc(suffix, strings.EqualFold))
+	// Output:
+	// true
+}
+
+func ExampleStartsWith() {
+	// It works even for an infinite sequence.
+	Println(StartsWith(IntsFrom(1), Range(1, 3)))
+	Println(StartsWith(IntsFrom(1), From([]int{1, 3})))
+	Println(StartsWith(Range(1, 2), Range(1, 3)))
+	// Output:
+	// true
+	// false
+	// false
+}
+
+func ExampleEndsWith() {
+	Println(EndsWith(FromString("index.html"), FromString(".html")))
+	Println(EndsWith(FromString("html"), FromString(".html")))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleCompareFunc() {
+	v1 := From(strings.Split("1.18.2", "."))
+	v2 := From(strings.Split("1.9", "."))
+	byNumber := func(a, b string) int {
+		var x, y int
+		Sscan(a, &x)
+		Sscan(b, &y)
+		return x - y
+	}
+	Println(CompareFunc(byNumber, v1, v2) > 0)
+	Println(CompareFunc(strings.Compare, v1, v2) > 0)
+	// Output:
+	// true
+	// false
+}
+
+func ExampleCompare() {
+	Println(Compare(Range(1, 3), Range(1, 3)))
+	Println(Compare(Range(1, 3), Range(1, 4)))
+	Println(Compare(From([]int{1, 3}), Range(1, 4)))
+
+	// It works for infinite sequences which differ.
+	Println(Compare(IntsFrom(0), IntsFrom(1)))
+	// Output:
+	// 0
+	// -1
+	// 1
+	// -1
+}
+
+func ExampleEnumerator_IsSortedBy() {
+	words := From([]string{"Go", "is", "FUN"})
+	Println(words.IsSortedBy(func(a, b string) bool {
+		return len(a) < len(b)
+	}))
+	Println(words.IsSortedBy(func(a, b string) bool {
+		return strings.ToLower(a) < strings.ToLower(b)
+	}))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleIsSorted() {
+	Println(IsSorted(From([]int{1, 2, 2, 5})))
+	Println(IsSorted(Empty[string]()))
+
+	// It stops at 1 after 5 even for an infinite sequence.
+	Println(IsSorted(From([]int{3, 5, 1}).Concat(IntsFrom(0))))
+	// Output:
+	// true
+	// true
+	// false
+}
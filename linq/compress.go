@@ -0,0 +1,86 @@
+package linq
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrUnsupportedCompression is the error for compressed data which no
+// registered decompressor can read, e.g. zstd without RegisterDecompressor.
+var ErrUnsupportedCompression = errors.New("linq: unsupported compression")
+
+// decompressor associates magic bytes with a function which decompresses
+// data beginning with them.
+type decompressor struct {
+	magic      []byte
+	decompress func(io.Reader) (io.Reader, error)
+}
+
+var (
+	decompressorsMu sync.RWMutex
+	decompressors   = []decompressor{
+		{[]byte{0x1f, 0x8b}, func(r io.Reader) (io.Reader, error) {
+			return gzip.NewReader(r)
+		}},
+		{[]byte("BZh"), func(r io.Reader) (io.Reader, error) {
+			return bzip2.NewReader(r), nil
+		}},
+		{[]byte{0x28, 0xb5, 0x2f, 0xfd}, func(io.Reader) (io.Reader, error) {
+			return nil, ErrUnsupportedCompression // zstd
+		}},
+	}
+)
+
+// RegisterDecompressor makes Decompress and FromCompressedReader use
+// decompress for data beginning with magic.
+// It takes precedence over the decompressors registered before for the
+// same magic.
+// gzip and bzip2 are registered by default.
+// zstd is recognized, but needs a decompressor from outside the standard
+// library, e.g.
+//
+//	linq.RegisterDecompressor([]byte{0x28, 0xb5, 0x2f, 0xfd},
+//		func(r io.Reader) (io.Reader, error) { return zstd.NewReader(r) })
+func RegisterDecompressor(magic []byte,
+	decompress func(io.Reader) (io.Reader, error)) {
+	decompressorsMu.Lock()
+	defer decompressorsMu.Unlock()
+	d := decompressor{append([]byte(nil), magic...), decompress}
+	decompressors = append([]decompressor{d}, decompressors...)
+}
+
+// Decompress returns a reader which yields the decompressed data of r if r
+// begins with the magic bytes of a registered format, or the data of r as
+// is otherwise.
+func Decompress(r io.Reader) (io.Reader, error) {
+	br := bufio.NewReader(r)
+	decompressorsMu.RLock()
+	ds := decompressors // never modified in place
+	decompressorsMu.RUnlock()
+	for _, d := range ds {
+		head, _ := br.Peek(len(d.magic))
+		if bytes.Equal(head, d.magic) {
+			return d.decompress(br)
+		}
+	}
+	return br, nil
+}
+
+// FromCompressedReader creates an Enumerator[string] which yields each
+// line of r, decompressing r as Decompress does.
+// The enumerator may panic with the error from Decompress or
+// scanner.Err().
+func FromCompressedReader(r io.Reader) Enumerator[string] {
+	return func(yield func(string)) {
+		dr, err := Decompress(r)
+		if err != nil {
+			panic(err)
+		}
+		FromReader(dr)(yield)
+	}
+}
@@ -0,0 +1,49 @@
+package linq
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	. "fmt"
+	"io"
+	"strings"
+)
+
+func ExampleFromCompressedReader() {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte("alpha\nbeta\ngamma\n"))
+	w.Close()
+	x := FromCompressedReader(&buf)
+	Printf("%q\n", x.ToSlice())
+
+	// Uncompressed data are read as is.
+	y := FromCompressedReader(strings.NewReader("plain\ntext\n"))
+	Printf("%q\n", y.ToSlice())
+	// Output:
+	// ["alpha" "beta" "gamma"]
+	// ["plain" "text"]
+}
+
+func ExampleDecompress() {
+	zstd := bytes.NewReader([]byte{0x28, 0xb5, 0x2f, 0xfd, 0, 0})
+	_, err := Decompress(zstd)
+	Println(errors.Is(err, ErrUnsupportedCompression))
+	// Output:
+	// true
+}
+
+func ExampleRegisterDecompressor() {
+	// A stand-in for a decoder from outside the standard library, e.g.
+	// lz4.NewReader, which here just drops the magic bytes
+	magic := []byte{0x04, 0x22, 0x4d, 0x18}
+	RegisterDecompressor(magic, func(r io.Reader) (io.Reader, error) {
+		_, err := io.ReadFull(r, make([]byte, len(magic)))
+		return r, err
+	})
+	data := append(magic, "framed\nlines\n"...)
+	x := FromCompressedReader(bytes.NewReader(data))
+	Printf("%q\n", x.ToSlice())
+	// Output:
+	// ["framed" "lines"]
+}
@@ -0,0 +1,60 @@
+package linq
+
+import (
+	"container/heap"
+	"container/ring"
+)
+
+// Heap is a binary heap ordered by a less function.
+// It implements heap.Interface; use it with heap.Push, heap.Pop etc.
+type Heap[T any] struct {
+	Elements []T
+	less     func(a, b T) bool
+}
+
+func (h *Heap[T]) Len() int { return len(h.Elements) }
+
+func (h *Heap[T]) Less(i, j int) bool {
+	return h.less(h.Elements[i], h.Elements[j])
+}
+
+func (h *Heap[T]) Swap(i, j int) {
+	h.Elements[i], h.Elements[j] = h.Elements[j], h.Elements[i]
+}
+
+// Push appends x to Elements; call heap.Push instead.
+func (h *Heap[T]) Push(x any) {
+	h.Elements = append(h.Elements, x.(T))
+}
+
+// Pop removes the last element of Elements; call heap.Pop instead.
+func (h *Heap[T]) Pop() any {
+	n := len(h.Elements) - 1
+	x := h.Elements[n]
+	h.Elements = h.Elements[:n]
+	return x
+}
+
+// ToHeap creates a Heap from the sequence with less.
+// The heap is initialized by heap.Init and ready to use.
+func (loop Enumerator[T]) ToHeap(less func(a, b T) bool) *Heap[T] {
+	h := &Heap[T]{loop.ToSlice(), less}
+	heap.Init(h)
+	return h
+}
+
+// ToRing creates a ring from the sequence.
+// It returns nil if the sequence is empty.
+func (loop Enumerator[T]) ToRing() *ring.Ring {
+	var r *ring.Ring
+	loop(func(element T) {
+		e := ring.New(1)
+		e.Value = element
+		if r == nil {
+			r = e
+		} else {
+			r.Prev().Link(e)
+		}
+	})
+	return r
+}
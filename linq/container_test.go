@@ -0,0 +1,34 @@
+package linq
+
+import (
+	"container/heap"
+	. "fmt"
+)
+
+func ExampleEnumerator_ToHeap() {
+	h := From([]int{5, 2, 8, 1}).ToHeap(func(a, b int) bool { return a < b })
+	heap.Push(h, 3)
+	for h.Len() > 0 {
+		Print(heap.Pop(h), " ")
+	}
+	Println()
+	// Output:
+	// 1 2 3 5 8
+}
+
+func ExampleEnumerator_ToRing() {
+	r := Range(1, 4).ToRing()
+	Println(r.Len())
+	r = r.Move(2)
+	r.Do(func(x any) {
+		Println(x)
+	})
+	Println(Empty[int]().ToRing() == nil)
+	// Output:
+	// 4
+	// 3
+	// 4
+	// 1
+	// 2
+	// true
+}
@@ -0,0 +1,88 @@
+package linq
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// MaxDelimitedRecord is the largest length of records which FromDelimited
+// and FromUint32Delimited accept, to guard against corrupt prefixes.
+const MaxDelimitedRecord = 64 << 20
+
+// ErrRecordTooLarge is the error for a record longer than
+// MaxDelimitedRecord.
+var ErrRecordTooLarge = errors.New("linq: record too large")
+
+// FromDelimited creates an Enumerator[T] which reads records, each prefixed
+// with its length as a varint, from r and yields the result of decode for
+// each of them.
+// It reads the format of protobuf's writeDelimitedTo, e.g. with
+// proto.Unmarshal as decode.
+// The slice passed to decode is reused for the next record; decode must
+// copy it to keep it.
+// The enumerator may panic with the error from r, decode or
+// ErrRecordTooLarge; a truncated record results in io.ErrUnexpectedEOF.
+func FromDelimited[T any](r io.Reader,
+	decode func([]byte) (T, error)) Enumerator[T] {
+	return func(yield func(T)) {
+		br, ok := r.(io.ByteReader)
+		if !ok {
+			b := bufio.NewReader(r)
+			br, r = b, b
+		}
+		readDelimited(r, func() (uint64, error) {
+			return binary.ReadUvarint(br)
+		}, decode, yield)
+	}
+}
+
+// FromUint32Delimited is a variant of FromDelimited for records prefixed
+// with their lengths as 4-byte unsigned integers in order.
+func FromUint32Delimited[T any](r io.Reader, order binary.ByteOrder,
+	decode func([]byte) (T, error)) Enumerator[T] {
+	return func(yield func(T)) {
+		var prefix [4]byte
+		readDelimited(r, func() (uint64, error) {
+			if _, err := io.ReadFull(r, prefix[:]); err != nil {
+				return 0, err // io.EOF only if no byte has been read
+			}
+			return uint64(order.Uint32(prefix[:])), nil
+		}, decode, yield)
+	}
+}
+
+// readDelimited reads records from r, each of which is preceded by the
+// length that readLength reads, until readLength returns io.EOF.
+func readDelimited[T any](r io.Reader, readLength func() (uint64, error),
+	decode func([]byte) (T, error), yield func(T)) {
+	var buf []byte
+	for {
+		n, err := readLength()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			panic(err)
+		}
+		if n > MaxDelimitedRecord {
+			panic(ErrRecordTooLarge)
+		}
+		if uint64(cap(buf)) < n {
+			buf = make([]byte, n)
+		}
+		buf = buf[:n]
+		if _, err := io.ReadFull(r, buf); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			panic(err)
+		}
+		element, err := decode(buf)
+		if err != nil {
+			panic(err)
+		}
+		yield(element)
+	}
+}
@@ -0,0 +1,40 @@
+package linq
+
+import (
+	"bytes"
+	"encoding/binary"
+	. "fmt"
+)
+
+func decodeString(b []byte) (string, error) {
+	return string(b), nil
+}
+
+func ExampleFromDelimited() {
+	var buf bytes.Buffer
+	for _, s := range []string{"alpha", "", "a record longer than 127 bytes" +
+		string(bytes.Repeat([]byte{'!'}, 100))} {
+		var prefix [binary.MaxVarintLen64]byte
+		buf.Write(prefix[:binary.PutUvarint(prefix[:], uint64(len(s)))])
+		buf.WriteString(s)
+	}
+	x := FromDelimited(&buf, decodeString)
+	Println(Select(func(s string) int { return len(s) }, x).ToSlice())
+	// Output:
+	// [5 0 130]
+}
+
+func ExampleFromUint32Delimited() {
+	data := []byte{0, 0, 0, 2, 'h', 'i', 0, 0, 0, 3, 'f', 'o', 'o'}
+	x := FromUint32Delimited(bytes.NewReader(data), binary.BigEndian,
+		decodeString)
+	Printf("%q\n", x.ToSlice())
+
+	// A truncated record results in io.ErrUnexpectedEOF.
+	y := FromUint32Delimited(bytes.NewReader(data[:8]), binary.BigEndian,
+		decodeString)
+	Println(Materialize(y).Last())
+	// Output:
+	// ["hi" "foo"]
+	// Some(OnError(unexpected EOF))
+}
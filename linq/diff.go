@@ -0,0 +1,163 @@
+package linq
+
+import "fmt"
+
+// EditOp is the kind of an Edit.
+type EditOp int
+
+// The kinds of Edit
+const (
+	EditKeep   EditOp = iota // an element in both sequences
+	EditDelete               // an element only in the old sequence
+	EditInsert               // an element only in the new sequence
+)
+
+// String returns " ", "-" or "+" as in unified diffs.
+func (op EditOp) String() string {
+	switch op {
+	case EditDelete:
+		return "-"
+	case EditInsert:
+		return "+"
+	default:
+		return " "
+	}
+}
+
+// Edit is an operation of the edit script from one sequence to another.
+type Edit[T any] struct {
+	Op       EditOp
+	Element  T
+	OldIndex int // the index in the old sequence, or -1 for EditInsert
+	NewIndex int // the index in the new sequence, or -1 for EditDelete
+}
+
+// String returns a string such as "+x", "-x" or " x".
+func (e Edit[T]) String() string {
+	return fmt.Sprintf("%s%v", e.Op, e.Element)
+}
+
+// Diff creates an Enumerator which yields the shortest edit script which
+// changes the sequence of oldLoop into that of newLoop, e.g. to detect
+// changes between two snapshots of a list or the lines of a file.
+// It buffers both sequences each time it is enumerated and computes the
+// script by Myers' O(ND) algorithm, where D is the number of the inserted
+// and deleted elements.
+// The deletions precede the insertions at each point of change.
+func Diff[T comparable](oldLoop, newLoop Enumerator[T]) Enumerator[Edit[T]] {
+	return DiffFunc(func(a, b T) bool { return a == b }, oldLoop, newLoop)
+}
+
+// DiffFunc is a variant of Diff which compares the elements with equal.
+func DiffFunc[T any](equal func(a, b T) bool,
+	oldLoop, newLoop Enumerator[T]) Enumerator[Edit[T]] {
+	return func(yield func(Edit[T])) {
+		a, b := oldLoop.ToSlice(), newLoop.ToSlice()
+		for _, e := range diff(equal, a, b) {
+			yield(e)
+		}
+	}
+}
+
+// diff computes the shortest edit script from a to b by Myers' algorithm.
+func diff[T any](equal func(a, b T) bool, a, b []T) []Edit[T] {
+	n, m := len(a), len(b)
+	max := n + m
+	// v[offset+k] is the furthest x on the diagonal k = x - y.
+	offset := max + 1
+	v := make([]int, 2*max+3)
+	// trace[d] is v[offset-d : offset+d+1] after the step d, i.e. the live
+	// diagonals only, so that the trace takes O(D*D) space.
+	var trace [][]int
+	last := 0 // the step which reaches (n, m)
+search:
+	for d := 0; d <= max; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || k != d && v[offset+k-1] < v[offset+k+1] {
+				x = v[offset+k+1] // down, i.e. an insertion
+			} else {
+				x = v[offset+k-1] + 1 // right, i.e. a deletion
+			}
+			y := x - k
+			for x < n && y < m && equal(a[x], b[y]) {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				last = d
+				break search
+			}
+		}
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+	}
+	// Trace the path back from (n, m).
+	var script []Edit[T]
+	x, y := n, m
+	for d := last; d >= 0; d-- {
+		prevX, prevY := 0, 0
+		if d > 0 {
+			prev := trace[d-1] // prev[i] is for the diagonal i-(d-1).
+			k := x - y
+			prevK := k - 1
+			if k == -d || k != d && prev[k-1+d-1] < prev[k+1+d-1] {
+				prevK = k + 1
+			}
+			prevX = prev[prevK+d-1]
+			prevY = prevX - prevK
+		}
+		for x > prevX && y > prevY {
+			x--
+			y--
+			script = append(script, Edit[T]{EditKeep, a[x], x, y})
+		}
+		if d > 0 {
+			if x == prevX {
+				y--
+				script = append(script, Edit[T]{EditInsert, b[y], -1, y})
+			} else {
+				x--
+				script = append(script, Edit[T]{EditDelete, a[x], x, -1})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	for i, j := 0, len(script)-1; i < j; i, j = i+1, j-1 {
+		script[i], script[j] = script[j], script[i]
+	}
+	return script
+}
+
+// LCS returns a longest common subsequence of the sequences of a and b,
+// i.e. the elements kept by the edit script of Diff.
+func LCS[T comparable](a, b Enumerator[T]) []T {
+	var result []T
+	Diff(a, b)(func(e Edit[T]) {
+		if e.Op == EditKeep {
+			result = append(result, e.Element)
+		}
+	})
+	return result
+}
+
+// SimilarityRatio returns a measure of the similarity of the sequences of
+// a and b in [0, 1], i.e. 2*M/T where M is the length of their longest
+// common subsequence and T is the sum of their lengths, as
+// difflib.SequenceMatcher.ratio of Python does roughly.
+// It returns 1 if both are empty.
+func SimilarityRatio[T comparable](a, b Enumerator[T]) float64 {
+	matches, total := 0, 0
+	Diff(a, b)(func(e Edit[T]) {
+		if e.Op == EditKeep {
+			matches += 2
+			total += 2
+		} else {
+			total++
+		}
+	})
+	if total == 0 {
+		return 1
+	}
+	return float64(matches) / float64(total)
+}
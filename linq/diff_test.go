@@ -0,0 +1,141 @@
+package linq
+
+import (
+	. "fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func ExampleDiff() {
+	old := FromString("ABCABBA")
+	new := FromString("CBABAC")
+	Diff(old, new)(func(e Edit[rune]) {
+		Printf("%s%c ", e.Op, e.Element)
+	})
+	Println()
+	// Output:
+	// -A -B  C +B  A  B -B  A +C
+}
+
+func ExampleDiffFunc() {
+	old := SplitString("host=a\nport=80\nuser=root", "\n")
+	new := SplitString("host=a\nPORT=8080\nuser=root\nlog=on", "\n")
+	sameKey := func(a, b string) bool {
+		return strings.EqualFold(strings.Split(a, "=")[0],
+			strings.Split(b, "=")[0])
+	}
+	DiffFunc(sameKey, old, new)(func(e Edit[string]) {
+		Println(e, e.OldIndex, e.NewIndex)
+	})
+	// Output:
+	//  host=a 0 0
+	//  port=80 1 1
+	//  user=root 2 2
+	// +log=on -1 3
+}
+
+// Example_diffProperty checks that Diff yields the shortest edit scripts
+// which change the old sequences into the new ones.
+func Example_diffProperty() {
+	r := rand.New(rand.NewSource(1))
+	random := func() []int {
+		x := make([]int, r.Intn(12))
+		for i := range x {
+			x[i] = r.Intn(3)
+		}
+		return x
+	}
+	for i := 0; i < 1000; i++ {
+		a, b := random(), random()
+		var olds, news []int
+		keeps := 0
+		Diff(From(a), From(b))(func(e Edit[int]) {
+			if e.Op != EditInsert {
+				olds = append(olds, e.Element)
+			}
+			if e.Op != EditDelete {
+				news = append(news, e.Element)
+			}
+			if e.Op == EditKeep {
+				keeps++
+			}
+		})
+		if !reflect.DeepEqual(olds, a) && len(a) > 0 ||
+			!reflect.DeepEqual(news, b) && len(b) > 0 {
+			Println("wrong script for", a, b)
+		}
+		if keeps != lcsLength(a, b) {
+			Println("longer script for", a, b)
+		}
+	}
+	Println("ok")
+	// Output: ok
+}
+
+// lcsLength computes the length of the longest common subsequence of a
+// and b by dynamic programming.
+func lcsLength(a, b []int) int {
+	t := make([][]int, len(a)+1)
+	for i := range t {
+		t[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				t[i][j] = t[i+1][j+1] + 1
+			} else if t[i+1][j] > t[i][j+1] {
+				t[i][j] = t[i+1][j]
+			} else {
+				t[i][j] = t[i][j+1]
+			}
+		}
+	}
+	return t[0][0]
+}
+
+func ExampleLCS() {
+	Println(string(LCS(FromString("ABCBDAB"), FromString("BDCABA"))))
+	x := LCS(Fields("the quick brown fox jumps"),
+		Fields("the brown dog jumps high"))
+	Println(x)
+	// Output:
+	// BDAB
+	// [the brown jumps]
+}
+
+func ExampleSimilarityRatio() {
+	Printf("%.3f\n", SimilarityRatio(FromString("kitten"),
+		FromString("sitting")))
+	Println(SimilarityRatio(Fields("a b c"), Fields("a b c")))
+	Println(SimilarityRatio(Fields("a b"), Fields("c d")))
+	Println(SimilarityRatio(Empty[string](), Empty[string]()))
+
+	// Find the most similar words.
+	words := From([]string{"apple", "apply", "ample", "maple"})
+	ratio := func(w string) float64 {
+		return SimilarityRatio(FromString(w), FromString("appel"))
+	}
+	best, _ := words.MaxFunc(func(a, b string) bool {
+		return ratio(a) < ratio(b)
+	})
+	Println(best)
+	// Output:
+	// 0.615
+	// 1
+	// 0
+	// 1
+	// apple
+}
+
+func BenchmarkDiff(b *testing.B) {
+	// Long sequences with a few differences; the trace should take space
+	// in proportion to the square of the differences, not to the lengths.
+	old := Range(0, 10000)
+	new := old.Where(func(i int) bool { return i%200 != 0 })
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Diff(old, new)(func(Edit[int]) {})
+	}
+}
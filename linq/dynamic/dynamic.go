@@ -0,0 +1,226 @@
+// Package dynamic selects and filters the elements of linq.Enumerator[any]
+// by field names and operators given at run time, e.g. from configuration.
+//
+// An element is a map with string keys, such as the ones decoded from JSON
+// into []map[string]any, or a struct or a pointer to a struct.
+// A name may be a dotted path such as "Owner.Name" to reach nested fields.
+package dynamic
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownOp is the error for an operator which WhereField does not
+// know.
+var ErrUnknownOp = errors.New("dynamic: unknown operator")
+
+// Field returns the value of x named name.
+// For a map, name is a key; for a struct, name is the name of an exported
+// field or the name in its json tag.
+// It returns false as the second value if there is no such value.
+func Field(x any, name string) (any, bool) {
+	for _, part := range strings.Split(name, ".") {
+		v, ok := field(reflect.ValueOf(x), part)
+		if !ok {
+			return nil, false
+		}
+		x = v.Interface()
+	}
+	return x, true
+}
+
+func field(v reflect.Value, name string) (reflect.Value, bool) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return v, false
+		}
+		e := v.MapIndex(reflect.ValueOf(name).Convert(v.Type().Key()))
+		return e, e.IsValid()
+	case reflect.Struct:
+		i, ok := fieldIndex(v.Type(), name)
+		if !ok {
+			return v, false
+		}
+		return v.Field(i), true
+	}
+	return v, false
+}
+
+type fieldKey struct {
+	t    reflect.Type
+	name string
+}
+
+var fieldIndexes sync.Map // fieldKey -> int, or -1 if there is none
+
+// fieldIndex returns the index of the exported field of t named name,
+// caching the result.
+func fieldIndex(t reflect.Type, name string) (int, bool) {
+	key := fieldKey{t, name}
+	if i, ok := fieldIndexes.Load(key); ok {
+		return i.(int), i.(int) >= 0
+	}
+	index := -1
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Name == name || tag == name {
+			index = i
+			break
+		}
+	}
+	fieldIndexes.Store(key, index)
+	return index, index >= 0
+}
+
+// SelectField returns a function which returns the value of its argument
+// named name, or nil if there is none; use it with linq.Select.
+func SelectField(name string) func(any) any {
+	return func(x any) any {
+		v, _ := Field(x, name)
+		return v
+	}
+}
+
+// WhereField returns a predicate which compares the value of its argument
+// named name with value by op; use it with Enumerator.Where.
+// op is one of ==, !=, <, <=, >, >= and contains, the last of which tests
+// a substring of a string or an element of a slice.
+// The elements without the value do not satisfy the predicate.
+// It panics with ErrUnknownOp for other op; see CheckOp to validate op in
+// advance.
+func WhereField(name, op string, value any) func(any) bool {
+	if err := CheckOp(op); err != nil {
+		panic(err)
+	}
+	return func(x any) bool {
+		v, ok := Field(x, name)
+		return ok && Test(v, op, value)
+	}
+}
+
+// CheckOp returns an error wrapping ErrUnknownOp if WhereField does not
+// know op.
+func CheckOp(op string) error {
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=", "contains":
+		return nil
+	}
+	return fmt.Errorf("%w: %q", ErrUnknownOp, op)
+}
+
+// Test reports whether a op b holds.
+// Numbers of different types are compared by their values, e.g. int 3 is
+// equal to float64 3 decoded from JSON.
+// The ordering operators hold only for two numbers or two strings.
+// It returns false for an unknown op.
+func Test(a any, op string, b any) bool {
+	switch op {
+	case "==":
+		return Equal(a, b)
+	case "!=":
+		return !Equal(a, b)
+	case "contains":
+		return contains(a, b)
+	}
+	c, ok := Compare(a, b)
+	if !ok {
+		return false
+	}
+	switch op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+// Equal reports whether a and b are equal, comparing numbers by their
+// values and the others by reflect.DeepEqual.
+func Equal(a, b any) bool {
+	if c, ok := Compare(a, b); ok {
+		return c == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// Compare returns -1, 0 or +1 according as a is less than, equal to or
+// greater than b, if both are numbers or both are strings.
+// It returns false as the second value otherwise.
+func Compare(a, b any) (int, bool) {
+	if x, ok := number(a); ok {
+		if y, ok := number(b); ok {
+			return compare(x, y), true
+		}
+		return 0, false
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() == reflect.String && vb.Kind() == reflect.String {
+		return compare(va.String(), vb.String()), true
+	}
+	return 0, false
+}
+
+func compare[T float64 | string](x, y T) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return +1
+	}
+	return 0
+}
+
+// number converts x to float64 if x is a number.
+func number(x any) (float64, bool) {
+	v := reflect.ValueOf(x)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Int64:
+		return float64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32,
+		reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), true
+	}
+	return 0, false
+}
+
+// contains reports whether a string a contains a string b, or a slice a
+// has an element equal to b.
+func contains(a, b any) bool {
+	va := reflect.ValueOf(a)
+	switch va.Kind() {
+	case reflect.String:
+		vb := reflect.ValueOf(b)
+		return vb.Kind() == reflect.String &&
+			strings.Contains(va.String(), vb.String())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < va.Len(); i++ {
+			if Equal(va.Index(i).Interface(), b) {
+				return true
+			}
+		}
+	}
+	return false
+}
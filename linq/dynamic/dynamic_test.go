@@ -0,0 +1,62 @@
+package dynamic
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+const petsJSON = `[
+  {"name": "Barley", "age": 8, "owner": {"name": "Alice"}},
+  {"name": "Boots", "age": 4, "owner": {"name": "Bob"}},
+  {"name": "Whiskers", "age": 1, "tags": ["cat", "young"]}
+]`
+
+func ExampleWhereField() {
+	var pets []any
+	json.Unmarshal([]byte(petsJSON), &pets)
+	// Both of the field name and the operator may come from configuration.
+	older := linq.From(pets).Where(WhereField("age", ">=", 4))
+	fmt.Println(linq.Select(SelectField("name"), older).ToSlice())
+
+	young := linq.From(pets).Where(WhereField("tags", "contains", "young"))
+	fmt.Println(linq.Select(SelectField("name"), young).ToSlice())
+
+	fmt.Println(CheckOp("=~"))
+	// Output:
+	// [Barley Boots]
+	// [Whiskers]
+	// dynamic: unknown operator: "=~"
+}
+
+func ExampleSelectField() {
+	type owner struct{ Name string }
+	type pet struct {
+		Name  string
+		Age   int `json:"age"`
+		Owner *owner
+	}
+	pets := linq.From([]any{
+		pet{"Barley", 8, &owner{"Alice"}},
+		&pet{"Boots", 4, nil},
+		map[string]any{"Name": "Daisy", "Owner": map[string]any{"Name": "Eve"}},
+	})
+	fmt.Println(linq.Select(SelectField("Owner.Name"), pets).ToSlice())
+	fmt.Println(linq.Select(SelectField("age"), pets).ToSlice())
+	// Output:
+	// [Alice <nil> Eve]
+	// [8 4 <nil>]
+}
+
+func ExampleCompare() {
+	fmt.Println(Compare(3, 2.5))
+	fmt.Println(Compare("a", "b"))
+	fmt.Println(Compare("a", 1))
+	fmt.Println(Equal(uint8(7), 7.0), Test([]int{1, 2}, "contains", 2.0))
+	// Output:
+	// 1 true
+	// -1 true
+	// 0 false
+	// true true
+}
@@ -0,0 +1,315 @@
+package dynamic
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"strconv"
+	"sync"
+)
+
+// ErrNotBool is the error for an operand of &&, || or ! which is not a
+// bool, or an expression of WhereExpr which is not a bool.
+var ErrNotBool = errors.New("dynamic: not a bool")
+
+// Expr is a compiled expression over the fields of an element.
+//
+// The syntax is a subset of Go's expressions:
+// the literals of numbers, strings, true, false and nil; names of the
+// fields as with Field, e.g. Age and Owner.Name; the comparison operators
+// ==, !=, <, <=, >, >=; the logical operators &&, || and !; and
+// parentheses.
+// The comparisons are evaluated as with Test.
+type Expr struct {
+	source string
+	eval   func(x any) any
+}
+
+// String returns the source of e.
+func (e *Expr) String() string {
+	return e.source
+}
+
+// Eval evaluates e against the fields of x.
+// If an operand of the logical operators is not a bool, it returns an
+// error wrapping ErrNotBool.
+func (e *Expr) Eval(x any) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(error); ok && errors.Is(e, ErrNotBool) {
+				err = e
+				return
+			}
+			panic(r)
+		}
+	}()
+	return e.eval(x), nil
+}
+
+// DefaultExprCacheSize is the initial capacity of the cache of Compile.
+const DefaultExprCacheSize = 256
+
+// exprCache is a cache of the results of Compile which discards the least
+// recently used ones beyond its capacity.
+type exprCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List // *Expr, the most recently used at the front
+	entries  map[string]*list.Element
+}
+
+var exprs = &exprCache{
+	capacity: DefaultExprCacheSize,
+	order:    list.New(),
+	entries:  make(map[string]*list.Element),
+}
+
+func (c *exprCache) get(source string) (*Expr, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[source]; ok {
+		c.order.MoveToFront(e)
+		return e.Value.(*Expr), true
+	}
+	return nil, false
+}
+
+func (c *exprCache) put(e *Expr) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[e.source]; ok || c.capacity <= 0 {
+		return
+	}
+	c.entries[e.source] = c.order.PushFront(e)
+	c.trim()
+}
+
+// trim discards the least recently used entries beyond the capacity.
+func (c *exprCache) trim() {
+	for c.order.Len() > c.capacity && c.order.Len() > 0 {
+		oldest := c.order.Back()
+		delete(c.entries, c.order.Remove(oldest).(*Expr).source)
+	}
+}
+
+// SetExprCacheSize sets the number of the expressions which Compile
+// caches, discarding the least recently used ones beyond it, and returns
+// the previous number.
+// The default is DefaultExprCacheSize.
+// A size of 0 or less disables the cache, e.g. for a process which
+// compiles many distinct expressions only once.
+func SetExprCacheSize(size int) int {
+	exprs.mu.Lock()
+	defer exprs.mu.Unlock()
+	prev := exprs.capacity
+	exprs.capacity = size
+	exprs.trim()
+	return prev
+}
+
+// Compile parses source into an Expr.
+// The results are cached, so that compiling the same source again is
+// cheap; the cache keeps the most recently used expressions up to the size
+// set by SetExprCacheSize.
+func Compile(source string) (*Expr, error) {
+	if e, ok := exprs.get(source); ok {
+		return e, nil
+	}
+	p := newParser(source)
+	eval, err := p.parse()
+	if err != nil {
+		return nil, err
+	}
+	e := &Expr{source, eval}
+	exprs.put(e)
+	return e, nil
+}
+
+// WhereExpr compiles source into a predicate over T, e.g.
+//
+//	older, err := dynamic.WhereExpr[Person](`Age >= 18 && Country == "JP"`)
+//	adults := people.Where(older)
+//
+// so that tools can accept filter strings from users.
+// The predicate panics with an error wrapping ErrNotBool if the
+// expression does not result in a bool for an element.
+func WhereExpr[T any](source string) (func(T) bool, error) {
+	e, err := Compile(source)
+	if err != nil {
+		return nil, err
+	}
+	return func(x T) bool {
+		if b, ok := e.eval(x).(bool); ok {
+			return b
+		}
+		panic(fmt.Errorf("%w: %s", ErrNotBool, source))
+	}, nil
+}
+
+// parser is a recursive descent parser of Expr.
+type parser struct {
+	s   scanner.Scanner
+	err error
+	pos token.Pos
+	tok token.Token
+	lit string
+}
+
+func newParser(source string) *parser {
+	p := new(parser)
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(source))
+	p.s.Init(file, []byte(source), func(pos token.Position, msg string) {
+		if p.err == nil {
+			p.err = fmt.Errorf("dynamic: %d: %s", pos.Column, msg)
+		}
+	}, 0)
+	p.next()
+	return p
+}
+
+func (p *parser) next() {
+	p.pos, p.tok, p.lit = p.s.Scan()
+	if p.tok == token.SEMICOLON && p.lit == "\n" { // automatic semicolon
+		p.pos, p.tok, p.lit = p.s.Scan()
+	}
+}
+
+// fail records an error at the current token.
+func (p *parser) fail(format string, args ...any) {
+	if p.err == nil {
+		p.err = fmt.Errorf("dynamic: %d: %s", int(p.pos),
+			fmt.Sprintf(format, args...))
+	}
+}
+
+func (p *parser) parse() (func(any) any, error) {
+	eval := p.or()
+	if p.tok != token.EOF {
+		p.fail("unexpected %s", p.tok)
+	}
+	return eval, p.err
+}
+
+// boolean returns the value of eval(x) as a bool.
+func boolean(eval func(any) any, x any) bool {
+	v := eval(x)
+	b, ok := v.(bool)
+	if !ok {
+		panic(fmt.Errorf("%w: %v", ErrNotBool, v))
+	}
+	return b
+}
+
+func (p *parser) or() func(any) any {
+	left := p.and()
+	for p.tok == token.LOR {
+		p.next()
+		l, r := left, p.and()
+		left = func(x any) any { return boolean(l, x) || boolean(r, x) }
+	}
+	return left
+}
+
+func (p *parser) and() func(any) any {
+	left := p.comparison()
+	for p.tok == token.LAND {
+		p.next()
+		l, r := left, p.comparison()
+		left = func(x any) any { return boolean(l, x) && boolean(r, x) }
+	}
+	return left
+}
+
+var comparisons = map[token.Token]string{
+	token.EQL: "==", token.NEQ: "!=", token.LSS: "<", token.LEQ: "<=",
+	token.GTR: ">", token.GEQ: ">=",
+}
+
+func (p *parser) comparison() func(any) any {
+	left := p.unary()
+	if op, ok := comparisons[p.tok]; ok {
+		p.next()
+		l, r := left, p.unary()
+		return func(x any) any { return Test(l(x), op, r(x)) }
+	}
+	return left
+}
+
+// unary parses ! above primary, so that !A == B means (!A) == B as in Go.
+func (p *parser) unary() func(any) any {
+	if p.tok == token.NOT {
+		p.next()
+		operand := p.unary()
+		return func(x any) any { return !boolean(operand, x) }
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() func(any) any {
+	pos, tok, lit := p.pos, p.tok, p.lit
+	if tok.IsKeyword() { // a field such as type or range in JSON
+		tok = token.IDENT
+	}
+	switch tok {
+	case token.LPAREN:
+		p.next()
+		e := p.or()
+		if p.tok != token.RPAREN {
+			p.fail("expected )")
+		}
+		p.next()
+		return e
+	case token.INT, token.FLOAT:
+		p.next()
+		if i, err := strconv.ParseInt(lit, 0, 64); err == nil {
+			return constant(i)
+		}
+		f, err := strconv.ParseFloat(lit, 64)
+		if err != nil {
+			p.fail("bad number %s", lit)
+		}
+		return constant(f)
+	case token.STRING, token.CHAR:
+		p.next()
+		s, err := strconv.Unquote(lit)
+		if err != nil {
+			p.fail("bad string %s", lit)
+		}
+		return constant(s)
+	case token.IDENT:
+		p.next()
+		switch lit {
+		case "true":
+			return constant(true)
+		case "false":
+			return constant(false)
+		case "nil":
+			return constant(nil)
+		}
+		name := lit
+		for p.tok == token.PERIOD {
+			p.next()
+			if p.tok != token.IDENT && !p.tok.IsKeyword() {
+				p.fail("expected a field name")
+				break
+			}
+			name += "." + p.lit
+			p.next()
+		}
+		return func(x any) any {
+			v, _ := Field(x, name)
+			return v
+		}
+	}
+	p.pos = pos
+	p.fail("unexpected %s", tok)
+	p.next()
+	return constant(nil)
+}
+
+func constant(v any) func(any) any {
+	return func(any) any { return v }
+}
@@ -0,0 +1,101 @@
+package dynamic
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+type person struct {
+	Name    string
+	Age     int
+	Country string
+}
+
+var people = linq.From([]person{
+	{"Taro", 20, "JP"}, {"Hanako", 17, "JP"}, {"John", 30, "US"},
+	{"Jiro", 18, "JP"},
+})
+
+func ExampleWhereExpr() {
+	adults, err := WhereExpr[person](`Age >= 18 && Country == "JP"`)
+	fmt.Println(err)
+	names := linq.Select(func(p person) string { return p.Name },
+		people.Where(adults))
+	fmt.Println(names.ToSlice())
+
+	_, err = WhereExpr[person](`Age >= && Country`)
+	fmt.Println(err)
+	// Output:
+	// <nil>
+	// [Taro Jiro]
+	// dynamic: 8: unexpected &&
+}
+
+func ExampleCompile() {
+	e, _ := Compile(`!(Age < 18 || Country != "JP") && Name != "Taro"`)
+	for _, p := range people.ToSlice() {
+		v, _ := e.Eval(p)
+		fmt.Print(v, "-")
+	}
+	fmt.Println()
+
+	// The operands of && must be bools.
+	e, _ = Compile(`Name && true`)
+	_, err := e.Eval(person{Name: "Taro"})
+	fmt.Println(errors.Is(err, ErrNotBool), err)
+
+	// ! binds tighter than == as in Go: (!Age) == 20 is an error.
+	e, _ = Compile(`!Age == 20`)
+	_, err = e.Eval(person{Age: 20})
+	fmt.Println(errors.Is(err, ErrNotBool))
+	e, _ = Compile(`!(Age == 20)`)
+	fmt.Println(e.Eval(person{Age: 20}))
+	// Output:
+	// false-false-false-true-
+	// true dynamic: not a bool: Taro
+	// true
+	// false <nil>
+}
+
+func ExampleCompile_keyword() {
+	// The keywords of Go can be field names, e.g. the keys of JSON.
+	row := map[string]any{"type": "a", "range": 5,
+		"map": map[string]any{"default": true}}
+	for _, source := range []string{`type == "a"`, `range > 3`,
+		`map.default`} {
+		e, err := Compile(source)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(e.Eval(row))
+	}
+	// Output:
+	// true <nil>
+	// true <nil>
+	// true <nil>
+}
+
+func ExampleSetExprCacheSize() {
+	prev := SetExprCacheSize(2)
+	defer SetExprCacheSize(prev)
+	a, _ := Compile("Age > 1")
+	Compile("Age > 2")
+	Compile("Age > 1") // Age > 1 becomes the most recently used.
+	Compile("Age > 3") // Age > 2 is discarded.
+	b, _ := Compile("Age > 1")
+	fmt.Println(prev, exprs.order.Len(), a == b)
+	_, ok := exprs.get("Age > 2")
+	fmt.Println(ok)
+
+	// The cache can be disabled.
+	SetExprCacheSize(0)
+	c, _ := Compile("Age > 1")
+	fmt.Println(exprs.order.Len(), a == c)
+	// Output:
+	// 256 2 true
+	// false
+	// 0 false
+}
@@ -0,0 +1,130 @@
+package linq
+
+import "fmt"
+
+// In this package, an error raised during enumeration is reported by
+// panic with the error value; see FromReader for example.
+// The functions below bring such errors into ordinary sequences.
+
+// tryLoop applies yield to each element of loop.
+// If loop panics with an error value outside yield, it recovers from the
+// panic and returns the error.
+// Panics raised within yield are not recovered.
+func tryLoop[T any](loop Enumerator[T], yield func(T)) (err error) {
+	inYield := false
+	defer func() {
+		if !inYield {
+			if r := recover(); r != nil {
+				if e, ok := r.(error); ok {
+					err = e
+					return
+				}
+				panic(r)
+			}
+		}
+	}()
+	loop(func(element T) {
+		inYield = true
+		yield(element)
+		inYield = false
+	})
+	return nil
+}
+
+// NotificationKind is the kind of a Notification.
+type NotificationKind int
+
+// The kinds of Notification
+const (
+	OnNext      NotificationKind = iota // an element
+	OnError                             // an error which ends the sequence
+	OnCompleted                         // the normal end of the sequence
+)
+
+// Notification represents an element, an error or the end of a sequence.
+type Notification[T any] struct {
+	Kind  NotificationKind
+	Value T     // the element if Kind is OnNext
+	Err   error // the error if Kind is OnError
+}
+
+// String returns a string such as "OnNext(1)", "OnError(EOF)" or
+// "OnCompleted()".
+func (n Notification[T]) String() string {
+	switch n.Kind {
+	case OnNext:
+		return fmt.Sprintf("OnNext(%v)", n.Value)
+	case OnError:
+		return fmt.Sprintf("OnError(%v)", n.Err)
+	default:
+		return "OnCompleted()"
+	}
+}
+
+// Materialize creates an Enumerator which yields each element of loop
+// as an OnNext notification and then an OnCompleted notification.
+// If loop panics with an error value, the panic will be recovered
+// and an OnError notification will be yielded at the end instead.
+func Materialize[T any](loop Enumerator[T]) Enumerator[Notification[T]] {
+	return func(yield func(Notification[T])) {
+		err := tryLoop(loop, func(element T) {
+			yield(Notification[T]{Kind: OnNext, Value: element})
+		})
+		if err != nil {
+			yield(Notification[T]{Kind: OnError, Err: err})
+		} else {
+			yield(Notification[T]{Kind: OnCompleted})
+		}
+	}
+}
+
+// Dematerialize creates an Enumerator which yields the value of each
+// OnNext notification of loop.
+// It terminates at an OnCompleted notification and panics with the error
+// at an OnError notification.
+func Dematerialize[T any](loop Enumerator[Notification[T]]) Enumerator[T] {
+	return func(yield func(T)) {
+		loop.LoopWithExit(func(n Notification[T], exit func()) {
+			switch n.Kind {
+			case OnNext:
+				yield(n.Value)
+			case OnError:
+				panic(n.Err)
+			default:
+				exit()
+			}
+		})
+	}
+}
+
+// Catch creates an Enumerator which yields the elements of the sequence.
+// If the sequence panics with an error value, the panic will be recovered
+// and the elements of handler(err) will be yielded after the elements
+// already yielded.
+func (loop Enumerator[T]) Catch(
+	handler func(error) Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		if err := tryLoop(loop, yield); err != nil {
+			handler(err)(yield)
+		}
+	}
+}
+
+// Retry creates an Enumerator which yields the elements of the sequence.
+// If the sequence panics with an error value, it will enumerate the sequence
+// again from the beginning, up to n times.
+// Note that the elements yielded before each error are not taken back.
+// If the last attempt also fails, it panics with the error.
+func (loop Enumerator[T]) Retry(n int) Enumerator[T] {
+	return func(yield func(T)) {
+		for i := 0; ; i++ {
+			err := tryLoop(loop, yield)
+			if err == nil {
+				return
+			}
+			if i >= n {
+				panic(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package linq
+
+import (
+	"errors"
+	. "fmt"
+)
+
+// failAfter returns a sequence which yields 1, 2, ..., n and then panics
+// with err.
+func failAfter(n int, err error) Enumerator[int] {
+	return func(yield func(int)) {
+		Range(1, n)(yield)
+		panic(err)
+	}
+}
+
+func ExampleMaterialize() {
+	Materialize(Range(1, 2))(func(n Notification[int]) {
+		Println(n)
+	})
+	Materialize(failAfter(2, errors.New("poi")))(func(n Notification[int]) {
+		Println(n)
+	})
+	// Output:
+	// OnNext(1)
+	// OnNext(2)
+	// OnCompleted()
+	// OnNext(1)
+	// OnNext(2)
+	// OnError(poi)
+}
+
+func ExampleDematerialize() {
+	seq := From([]Notification[string]{
+		{Kind: OnNext, Value: "a"},
+		{Kind: OnNext, Value: "b"},
+		{Kind: OnCompleted},
+		{Kind: OnNext, Value: "c"},
+	})
+	Printf("%v\n", Dematerialize(seq).ToSlice())
+
+	defer func() {
+		Println("recovered:", recover())
+	}()
+	errs := Materialize(failAfter(1, errors.New("poi")))
+	Dematerialize(errs)(func(i int) {
+		Println(i)
+	})
+	// Output:
+	// [a b]
+	// 1
+	// recovered: poi
+}
+
+func ExampleEnumerator_Catch() {
+	x := failAfter(3, errors.New("poi")).Catch(func(err error) Enumerator[int] {
+		Println("caught:", err)
+		return From([]int{-1, -2})
+	})
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// caught: poi
+	// [1 2 3 -1 -2]
+}
+
+func ExampleEnumerator_Retry() {
+	attempts := 0
+	var flaky Enumerator[string] = func(yield func(string)) {
+		attempts++
+		if attempts < 3 {
+			panic(Errorf("attempt %d failed", attempts))
+		}
+		yield("ok")
+	}
+	x := flaky.Retry(5)
+	Printf("%v %d\n", x.ToSlice(), attempts)
+
+	defer func() {
+		Println("recovered:", recover())
+	}()
+	failAfter(0, errors.New("poi")).Retry(2).ToSlice()
+	// Output:
+	// [ok] 3
+	// recovered: poi
+}
@@ -0,0 +1,143 @@
+package linq
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// fanOutBuffer is the number of elements which each branch of PartitionBy,
+// SplitRoundRobin and Broadcast buffers.
+const fanOutBuffer = 64
+
+// fanOut creates n branches fed by a goroutine which enumerates loop and
+// calls route for each element; route passes the element to the i-th
+// branch by calling send(i), which reports whether the branch accepted it.
+// The goroutine starts when any branch is enumerated first, and blocks
+// while a branch which has not stopped has a full buffer.
+// A branch stops when its enumeration completes or terminates early; the
+// goroutine stops loop when all the branches have stopped.
+// Each branch can be enumerated only once; it yields nothing afterwards.
+func fanOut[T any](loop Enumerator[T], n int,
+	route func(element T, send func(i int) bool)) []Enumerator[T] {
+	chans := make([]chan T, n)
+	quits := make([]chan struct{}, n)
+	stops := make([]sync.Once, n)
+	for i := range chans {
+		chans[i] = make(chan T, fanOutBuffer)
+		quits[i] = make(chan struct{})
+	}
+	active := int32(n)
+	var start sync.Once
+	var failure any
+	run := func() {
+		defer func() {
+			for _, c := range chans {
+				close(c)
+			}
+		}()
+		defer func() {
+			failure = recover()
+		}()
+		loop.LoopWithExit(func(element T, exit func()) {
+			route(element, func(i int) bool {
+				select {
+				case <-quits[i]:
+					return false
+				default:
+				}
+				select {
+				case chans[i] <- element:
+					return true
+				case <-quits[i]:
+					return false
+				}
+			})
+			if atomic.LoadInt32(&active) == 0 {
+				exit()
+			}
+		})
+	}
+	result := make([]Enumerator[T], n)
+	for i := range result {
+		i := i
+		stop := func() {
+			stops[i].Do(func() {
+				close(quits[i])
+				atomic.AddInt32(&active, -1)
+			})
+		}
+		result[i] = func(yield func(T)) {
+			select {
+			case <-quits[i]:
+				return // enumerated already
+			default:
+			}
+			defer stop()
+			start.Do(func() {
+				go run()
+			})
+			for element := range chans[i] {
+				yield(element)
+			}
+			if failure != nil {
+				panic(failure)
+			}
+		}
+	}
+	return result
+}
+
+// PartitionBy creates n Enumerators, to each of which the elements of the
+// sequence are routed by hash(element) % n, enumerating the sequence only
+// once in another goroutine; e.g. for sharded parallel processing in which
+// the elements of the same key go to the same shard.
+// The Enumerators must be enumerated concurrently, e.g. in their own
+// goroutines, since each of them buffers a limited number of elements and
+// a full one blocks the others.
+// The elements for an Enumerator which has terminated early are dropped.
+// Each Enumerator can be enumerated only once.
+// If the sequence panics, each of them will panic with the same value.
+func (loop Enumerator[T]) PartitionBy(n int,
+	hash func(T) uint64) []Enumerator[T] {
+	return fanOut(loop, n, func(element T, send func(int) bool) {
+		send(int(hash(element) % uint64(n)))
+	})
+}
+
+// SplitRoundRobin creates n Enumerators which take the elements of the
+// sequence in turn, enumerating the sequence only once in another
+// goroutine; each element goes to exactly one of them, e.g. for a pool of
+// workers.
+// An element for an Enumerator which has terminated early goes to the next
+// one instead, but the elements already buffered for it are dropped.
+// The Enumerators must be enumerated concurrently as with PartitionBy.
+// Each Enumerator can be enumerated only once.
+// If the sequence panics, each of them will panic with the same value.
+func (loop Enumerator[T]) SplitRoundRobin(n int) []Enumerator[T] {
+	next := 0
+	return fanOut(loop, n, func(element T, send func(int) bool) {
+		for k := 0; k < n; k++ {
+			i := next
+			next = (next + 1) % n
+			if send(i) {
+				return
+			}
+		}
+	})
+}
+
+// Broadcast creates n Enumerators, each of which yields every element of
+// the sequence, enumerating the sequence only once in another goroutine.
+// Unlike Tee, which buffers as many elements as the gap between the
+// Enumerators, it buffers a limited number of elements for each of them,
+// so that the fastest one waits for the slowest one.
+// The Enumerators must be enumerated concurrently as with PartitionBy.
+// Each Enumerator can be enumerated only once.
+// If the sequence panics, each of them will panic with the same value.
+func (loop Enumerator[T]) Broadcast(n int) []Enumerator[T] {
+	return fanOut(loop, n, func(element T, send func(int) bool) {
+		for i := 0; i < n; i++ {
+			send(i)
+		}
+	})
+}
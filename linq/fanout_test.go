@@ -0,0 +1,57 @@
+package linq
+
+import (
+	. "fmt"
+	"sync"
+)
+
+// consume enumerates each of loops in its own goroutine and returns the
+// results of ToSlice.
+func consume[T any](loops []Enumerator[T]) [][]T {
+	result := make([][]T, len(loops))
+	var wg sync.WaitGroup
+	for i, loop := range loops {
+		wg.Add(1)
+		go func(i int, loop Enumerator[T]) {
+			defer wg.Done()
+			result[i] = loop.ToSlice()
+		}(i, loop)
+	}
+	wg.Wait()
+	return result
+}
+
+func ExampleEnumerator_PartitionBy() {
+	words := From([]string{"apple", "bob", "avocado", "cat", "banana", "c"})
+	shards := words.PartitionBy(3, func(s string) uint64 {
+		return uint64(s[0] - 'a')
+	})
+	for _, shard := range consume(shards) {
+		Println(shard)
+	}
+	// Output:
+	// [apple avocado]
+	// [bob banana]
+	// [cat c]
+}
+
+func ExampleEnumerator_SplitRoundRobin() {
+	for _, branch := range consume(Range(1, 7).SplitRoundRobin(3)) {
+		Println(branch)
+	}
+	// Output:
+	// [1 4 7]
+	// [2 5]
+	// [3 6]
+}
+
+func ExampleEnumerator_Broadcast() {
+	branches := Range(1, 1000).Broadcast(3)
+	branches[1] = branches[1].Where(func(i int) bool { return i%2 == 0 })
+	branches[2] = branches[2].Take(5)
+	sums := Select(func(x []int) int { return Sum(From(x)) },
+		From(consume(branches)))
+	Println(sums.ToSlice())
+	// Output:
+	// [500500 250500 15]
+}
@@ -0,0 +1,82 @@
+package linq
+
+import (
+	"io/fs"
+	"path"
+	"strings"
+)
+
+// FromGlob creates an Enumerator[string] which yields the names of the
+// files and directories in fsys matching pattern, in lexical order.
+// The pattern is a slash-separated path whose elements are as in
+// path.Match, except that "**" matches zero or more directories, e.g.
+// "log/**/*.log".
+// It walks the directories lazily, only those which may contain matches,
+// so that FromGlob(fsys, "**/*.log").Take(100) stops walking once 100
+// names are yielded.
+// As with fs.Glob, the errors in reading directories are ignored.
+// The enumerator panics with path.ErrBadPattern if pattern is malformed.
+func FromGlob(fsys fs.FS, pattern string) Enumerator[string] {
+	return func(yield func(string)) {
+		elements := strings.Split(pattern, "/")
+		for _, e := range elements {
+			if _, err := path.Match(e, ""); err != nil {
+				panic(err)
+			}
+		}
+		// Walk from the longest prefix which has no meta characters.
+		n := 0
+		for n < len(elements)-1 && !hasGlobMeta(elements[n]) {
+			n++
+		}
+		root := "."
+		if n > 0 {
+			root = path.Join(elements[:n]...)
+		}
+		fs.WalkDir(fsys, root, func(name string, d fs.DirEntry,
+			err error) error {
+			if err != nil {
+				return nil
+			}
+			var names []string
+			if name != "." {
+				names = strings.Split(name, "/")
+			}
+			if name != "." && globMatch(elements, names, false) {
+				yield(name)
+			}
+			if d.IsDir() && !globMatch(elements, names, true) {
+				return fs.SkipDir
+			}
+			return nil
+		})
+	}
+}
+
+// hasGlobMeta reports whether element has any of the special characters
+// of path.Match.
+func hasGlobMeta(element string) bool {
+	return strings.ContainsAny(element, `*?[\`)
+}
+
+// globMatch reports whether the elements of a path match those of a
+// pattern.
+// If prefix is true, it reports instead whether the path is a directory
+// which may contain matches.
+func globMatch(pattern, names []string, prefix bool) bool {
+	if len(pattern) == 0 {
+		return len(names) == 0 && !prefix
+	}
+	if pattern[0] == "**" {
+		if prefix {
+			return true
+		}
+		return globMatch(pattern[1:], names, false) ||
+			len(names) > 0 && globMatch(pattern, names[1:], false)
+	}
+	if len(names) == 0 {
+		return prefix
+	}
+	ok, _ := path.Match(pattern[0], names[0])
+	return ok && globMatch(pattern[1:], names[1:], prefix)
+}
@@ -0,0 +1,53 @@
+package linq
+
+import (
+	. "fmt"
+	"io/fs"
+	"testing/fstest"
+)
+
+var globFS = fstest.MapFS{
+	"a.log":           {},
+	"var/b.log":       {},
+	"var/c.txt":       {},
+	"var/log/d.log":   {},
+	"var/log/x/e.log": {},
+	"var/tmp/f.log":   {},
+	"etc/g.log":       {},
+}
+
+// readDirFS records the directories read.
+type readDirFS struct {
+	fstest.MapFS
+	read []string
+}
+
+func (f *readDirFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	f.read = append(f.read, name)
+	return f.MapFS.ReadDir(name)
+}
+
+func ExampleFromGlob() {
+	Println(FromGlob(globFS, "var/**/*.log").ToSlice())
+	Println(FromGlob(globFS, "**/*.log").ToSlice())
+	Println(FromGlob(globFS, "var/*/*.log").ToSlice())
+	Println(FromGlob(globFS, "*").ToSlice())
+
+	// It stops walking once enough names are yielded.
+	f := &readDirFS{MapFS: globFS}
+	Println(FromGlob(f, "**/*.log").Take(2).ToSlice(), f.read)
+
+	// It reads only the directories which may contain matches.
+	f.read = nil
+	Println(FromGlob(f, "*/log/*.log").ToSlice(), f.read)
+
+	Println(Materialize(FromGlob(globFS, "var/[")).ToSlice())
+	// Output:
+	// [var/b.log var/log/d.log var/log/x/e.log var/tmp/f.log]
+	// [a.log etc/g.log var/b.log var/log/d.log var/log/x/e.log var/tmp/f.log]
+	// [var/log/d.log var/tmp/f.log]
+	// [a.log etc var]
+	// [a.log etc/g.log] [. etc]
+	// [var/log/d.log] [. etc var var/log]
+	// [OnError(syntax error in pattern)]
+}
@@ -0,0 +1,40 @@
+package linq
+
+import (
+	"encoding/gob"
+	"io"
+)
+
+// ToGob writes each element of loop to w as a gob value.
+// It stops the enumeration at the first error from the encoder and returns
+// the error.
+// FromGob reads the elements back.
+func ToGob[T any](w io.Writer, loop Enumerator[T]) error {
+	enc := gob.NewEncoder(w)
+	return loop.ForEachErr(func(element T) error {
+		return enc.Encode(element)
+	})
+}
+
+// FromGob creates an Enumerator[T] which decodes gob values of T from r,
+// e.g. the ones written by ToGob.
+// Since r is consumed, the enumerator yields nothing when it is enumerated
+// again.
+// The enumerator may panic with the error from the decoder other than
+// io.EOF.
+func FromGob[T any](r io.Reader) Enumerator[T] {
+	return func(yield func(T)) {
+		dec := gob.NewDecoder(r)
+		for {
+			var element T
+			err := dec.Decode(&element)
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				panic(err)
+			}
+			yield(element)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package linq
+
+import (
+	. "fmt"
+	"os"
+)
+
+func ExampleToGob() {
+	type Point struct{ X, Y int }
+	points := Select(func(i int) Point { return Point{i, i * i} }, Range(1, 4))
+
+	// Spill the points to a file and read them back later.
+	f, _ := os.CreateTemp("", "linq-*.gob")
+	path := f.Name()
+	defer os.Remove(path)
+	Println(ToGob(f, points))
+	f.Close()
+
+	x := Using(func() (*os.File, error) {
+		return os.Open(path)
+	}, func(f *os.File) Enumerator[Point] {
+		return FromGob[Point](f)
+	})
+	Printf("%v\n", x.ToSlice())
+	Printf("%v\n", x.Skip(3).ToSlice())
+	// Output:
+	// <nil>
+	// [{1 1} {2 4} {3 9} {4 16}]
+	// [{4 16}]
+}
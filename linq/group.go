@@ -0,0 +1,110 @@
+package linq
+
+// Grouping represents elements which have a common key.
+type Grouping[K any, T any] struct {
+	Key      K
+	Elements []T
+}
+
+// GroupBy creates an Enumerator which groups the elements of loop by the
+// keys which keySelector extracts.
+// The groups are yielded in the order of the first appearance of their
+// keys, and the elements in each group keep their order in loop.
+// It buffers all the elements each time it is enumerated.
+func GroupBy[T any, K comparable](keySelector func(T) K,
+	loop Enumerator[T]) Enumerator[Grouping[K, T]] {
+	return GroupByElement(keySelector, func(e T) T { return e }, loop)
+}
+
+// GroupByElement is a variant of GroupBy.
+// It groups elementSelector(element) instead of each element.
+func GroupByElement[T any, K comparable, E any](keySelector func(T) K,
+	elementSelector func(T) E, loop Enumerator[T]) Enumerator[Grouping[K, E]] {
+	return func(yield func(Grouping[K, E])) {
+		var keys []K
+		groups := make(map[K][]E)
+		loop(func(element T) {
+			k := keySelector(element)
+			elements, ok := groups[k]
+			if !ok {
+				keys = append(keys, k)
+			}
+			groups[k] = append(elements, elementSelector(element))
+		})
+		for _, k := range keys {
+			yield(Grouping[K, E]{k, groups[k]})
+		}
+	}
+}
+
+// GroupByResult is a variant of GroupBy.
+// It yields resultSelector(key, elements) for each group.
+func GroupByResult[T any, K comparable, R any](keySelector func(T) K,
+	resultSelector func(K, Enumerator[T]) R, loop Enumerator[T]) Enumerator[R] {
+	return GroupByElementResult(keySelector, func(e T) T { return e },
+		resultSelector, loop)
+}
+
+// GroupByElementResult is a variant of GroupBy.
+// It groups elementSelector(element) instead of each element and yields
+// resultSelector(key, elements) for each group.
+func GroupByElementResult[T any, K comparable, E any, R any](
+	keySelector func(T) K, elementSelector func(T) E,
+	resultSelector func(K, Enumerator[E]) R,
+	loop Enumerator[T]) Enumerator[R] {
+	return Select(func(g Grouping[K, E]) R {
+		return resultSelector(g.Key, From(g.Elements))
+	}, GroupByElement(keySelector, elementSelector, loop))
+}
+
+// KeyBy2 combines key1 and key2 into a key selector which returns a
+// comparable Pair, so that the operators with keys, e.g. GroupBy,
+// LeftJoin, JoinMap and ToIndex, can use composite keys such as (country,
+// year) without concatenating strings or defining structs.
+func KeyBy2[T any, K1 comparable, K2 comparable](key1 func(T) K1,
+	key2 func(T) K2) func(T) Pair[K1, K2] {
+	return func(element T) Pair[K1, K2] {
+		return Pair[K1, K2]{key1(element), key2(element)}
+	}
+}
+
+// KeyBy3 is a variant of KeyBy2 for three keys.
+func KeyBy3[T any, K1 comparable, K2 comparable, K3 comparable](
+	key1 func(T) K1, key2 func(T) K2,
+	key3 func(T) K3) func(T) Triple[K1, K2, K3] {
+	return func(element T) Triple[K1, K2, K3] {
+		return Triple[K1, K2, K3]{key1(element), key2(element), key3(element)}
+	}
+}
+
+// GroupBy2 is a variant of GroupBy which groups the elements by the pairs
+// of the keys which key1 and key2 extract.
+func GroupBy2[T any, K1 comparable, K2 comparable](key1 func(T) K1,
+	key2 func(T) K2,
+	loop Enumerator[T]) Enumerator[Grouping[Pair[K1, K2], T]] {
+	return GroupBy(KeyBy2(key1, key2), loop)
+}
+
+// SortedGroupAdjacent creates an Enumerator which groups the elements of
+// loop by the keys which keySelector extracts, assuming that the elements
+// with equal keys are adjacent, e.g. loop is sorted by the keys.
+// Each group is yielded as soon as the key changes; thus it buffers only
+// the current group and works for infinite sequences.
+func SortedGroupAdjacent[T any, K comparable](keySelector func(T) K,
+	loop Enumerator[T]) Enumerator[Grouping[K, T]] {
+	return func(yield func(Grouping[K, T])) {
+		var current Grouping[K, T]
+		loop(func(element T) {
+			k := keySelector(element)
+			if current.Elements != nil && k != current.Key {
+				yield(current)
+				current = Grouping[K, T]{}
+			}
+			current.Key = k
+			current.Elements = append(current.Elements, element)
+		})
+		if current.Elements != nil {
+			yield(current)
+		}
+	}
+}
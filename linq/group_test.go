@@ -0,0 +1,121 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+type groupPet struct {
+	Name string
+	Age  int
+}
+
+var groupPets = []groupPet{
+	{"Barley", 8}, {"Boots", 4}, {"Whiskers", 1}, {"Daisy", 4},
+}
+
+func ExampleGroupBy() {
+	x := GroupBy(func(p groupPet) int { return p.Age }, From(groupPets))
+	x(func(g Grouping[int, groupPet]) {
+		Println(g.Key, g.Elements)
+	})
+	// Output:
+	// 8 [{Barley 8}]
+	// 4 [{Boots 4} {Daisy 4}]
+	// 1 [{Whiskers 1}]
+}
+
+func ExampleGroupByElement() {
+	x := GroupByElement(func(p groupPet) int { return p.Age },
+		func(p groupPet) string { return p.Name }, From(groupPets))
+	x(func(g Grouping[int, string]) {
+		Println(g.Key, g.Elements)
+	})
+	// Output:
+	// 8 [Barley]
+	// 4 [Boots Daisy]
+	// 1 [Whiskers]
+}
+
+func ExampleGroupByResult() {
+	x := GroupByResult(func(p groupPet) bool { return p.Age >= 4 },
+		func(adult bool, pets Enumerator[groupPet]) string {
+			return Sprintf("%v: %d", adult, pets.Count())
+		}, From(groupPets))
+	Printf("%q\n", x.ToSlice())
+	// Output:
+	// ["true: 3" "false: 1"]
+}
+
+func ExampleGroupByElementResult() {
+	x := GroupByElementResult(func(s string) int { return len(s) },
+		func(s string) rune { return []rune(s)[0] },
+		func(n int, initials Enumerator[rune]) string {
+			return Sprintf("%d:%s", n, ToString(initials))
+		}, From([]string{"apple", "fig", "mango", "kiwi", "pear", "nut"}))
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [5:am 3:fn 4:kp]
+}
+
+func ExampleSortedGroupAdjacent() {
+	lines := From([]string{
+		"09:00 start", "09:00 login", "09:01 query", "09:03 logout",
+	})
+	x := SortedGroupAdjacent(func(s string) string { return s[:5] }, lines)
+	x(func(g Grouping[string, string]) {
+		Println(g.Key, len(g.Elements))
+	})
+
+	// It works lazily for an infinite sequence.
+	y := SortedGroupAdjacent(func(i int) int { return i / 3 }, IntsFrom(0))
+	Printf("%v\n", y.Take(2).ToSlice())
+	// Output:
+	// 09:00 2
+	// 09:01 1
+	// 09:03 1
+	// [{0 [0 1 2]} {1 [3 4 5]}]
+}
+
+type groupSale struct {
+	Country string
+	Year    int
+	Amount  int
+}
+
+var groupSales = []groupSale{
+	{"JP", 2023, 10}, {"US", 2023, 20}, {"JP", 2024, 30}, {"JP", 2023, 40},
+}
+
+func ExampleGroupBy2() {
+	country := func(s groupSale) string { return s.Country }
+	year := func(s groupSale) int { return s.Year }
+	GroupBy2(country, year, From(groupSales))(
+		func(g Grouping[Pair[string, int], groupSale]) {
+			total := Sum(Select(func(s groupSale) int { return s.Amount },
+				From(g.Elements)))
+			Println(g.Key.First, g.Key.Second, total)
+		})
+	// Output:
+	// JP 2023 50
+	// US 2023 20
+	// JP 2024 30
+}
+
+func ExampleKeyBy2() {
+	type target struct {
+		Country string
+		Year    int
+		Goal    int
+	}
+	targets := From([]target{{"JP", 2023, 45}, {"JP", 2024, 35}})
+	key := KeyBy2(func(s groupSale) string { return s.Country },
+		func(s groupSale) int { return s.Year })
+	targetKey := KeyBy2(func(t target) string { return t.Country },
+		func(t target) int { return t.Year })
+	x := LeftJoin(key, targetKey, func(s groupSale, t target) string {
+		return Sprint(s.Amount, "/", t.Goal)
+	}, From(groupSales), targets)
+	Println(x.ToSlice())
+	// Output:
+	// [10/45 20/0 30/35 40/45]
+}
@@ -0,0 +1,143 @@
+package linq
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// FromPager creates an Enumerator which fetches pages of items lazily.
+// fetch is called with cursor "" at first and then with the next cursor it
+// returned, until the next cursor is "".
+// Each page is fetched only when the consumer demands its elements; thus
+// Take(n) fetches just enough pages.
+// If fetch returns an error, the enumerator will panic with it.
+func FromPager[T any](fetch func(cursor string) (items []T, next string,
+	err error)) Enumerator[T] {
+	return FromPagerContext(context.Background(),
+		func(_ context.Context, cursor string) ([]T, string, error) {
+			return fetch(cursor)
+		})
+}
+
+// FromPagerContext is a variant of FromPager with ctx.
+// fetch is called with ctx.
+// If ctx is done before fetching a page, the enumerator will panic with
+// ctx.Err().
+func FromPagerContext[T any](ctx context.Context,
+	fetch func(ctx context.Context, cursor string) (items []T, next string,
+		err error)) Enumerator[T] {
+	return func(yield func(T)) {
+		cursor := ""
+		for {
+			if err := ctx.Err(); err != nil {
+				panic(err)
+			}
+			items, next, err := fetch(ctx, cursor)
+			if err != nil {
+				panic(err)
+			}
+			for _, item := range items {
+				yield(item)
+			}
+			if next == "" {
+				return
+			}
+			cursor = next
+		}
+	}
+}
+
+// FromBodyLines creates an Enumerator[string] which yields each line of
+// a streaming body such as http.Response.Body.
+// The body will be closed when the enumeration completes or terminates
+// early, or when ctx is done; closing it unblocks a pending read.
+// If ctx is done, the enumerator will panic with ctx.Err().
+// Otherwise it may panic with the error from reading the body.
+func FromBodyLines(ctx context.Context,
+	body io.ReadCloser) Enumerator[string] {
+	return func(yield func(string)) {
+		done := make(chan struct{})
+		defer close(done)
+		defer body.Close()
+		go func() {
+			select {
+			case <-ctx.Done():
+				body.Close()
+			case <-done:
+			}
+		}()
+		err := tryLoop(FromReader(body), func(line string) {
+			if err := ctx.Err(); err != nil {
+				panic(err)
+			}
+			yield(line)
+		})
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				panic(ctxErr)
+			}
+			panic(err)
+		}
+	}
+}
+
+// SSEEvent represents an event of Server-Sent Events.
+type SSEEvent struct {
+	Type  string // the "event" field; "message" by default
+	Data  string // the "data" fields joined with "\n"
+	ID    string // the last "id" field
+	Retry int    // the "retry" field in milliseconds, or 0
+}
+
+// FromSSE creates an Enumerator[SSEEvent] which parses a stream of
+// Server-Sent Events (text/event-stream) and yields each event lazily.
+// Comment lines and events without data are skipped.
+// As the specification requires, an event which is not terminated by a
+// blank line at the end of the stream is discarded.
+// The enumerator may panic with the error from reading r.
+func FromSSE(r io.Reader) Enumerator[SSEEvent] {
+	return func(yield func(SSEEvent)) {
+		var event SSEEvent
+		var data []string
+		lastID := ""
+		dispatch := func() {
+			if data != nil {
+				event.Data = strings.Join(data, "\n")
+				event.ID = lastID
+				if event.Type == "" {
+					event.Type = "message"
+				}
+				yield(event)
+			}
+			event = SSEEvent{}
+			data = nil
+		}
+		FromReader(r)(func(line string) {
+			if line == "" {
+				dispatch()
+				return
+			}
+			if strings.HasPrefix(line, ":") {
+				return // comment
+			}
+			field, value := line, ""
+			if i := strings.IndexByte(line, ':'); i >= 0 {
+				field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+			}
+			switch field {
+			case "event":
+				event.Type = value
+			case "data":
+				data = append(data, value)
+			case "id":
+				lastID = value
+			case "retry":
+				if ms, err := strconv.Atoi(value); err == nil {
+					event.Retry = ms
+				}
+			}
+		})
+	}
+}
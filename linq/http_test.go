@@ -0,0 +1,131 @@
+package linq
+
+import (
+	"context"
+	. "fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// fetchNumbers simulates a REST API which returns 3 numbers per page.
+func fetchNumbers(cursor string) ([]int, string, error) {
+	Printf("fetch %q\n", cursor)
+	start := 0
+	if cursor != "" {
+		var err error
+		if start, err = strconv.Atoi(cursor); err != nil {
+			return nil, "", err
+		}
+	}
+	if start >= 9 {
+		return Range(start, 1).ToSlice(), "", nil
+	}
+	return Range(start, 3).ToSlice(), strconv.Itoa(start + 3), nil
+}
+
+func ExampleFromPager() {
+	x := FromPager(fetchNumbers).Take(5)
+	Printf("%v\n", x.ToSlice())
+
+	Println(FromPager(fetchNumbers).Count())
+	// Output:
+	// fetch ""
+	// fetch "3"
+	// [0 1 2 3 4]
+	// fetch ""
+	// fetch "3"
+	// fetch "6"
+	// fetch "9"
+	// 10
+}
+
+func ExampleFromPagerContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	loop := FromPagerContext(ctx,
+		func(ctx context.Context, cursor string) ([]int, string, error) {
+			items, next, err := fetchNumbers(cursor)
+			if next == "6" {
+				cancel()
+			}
+			return items, next, err
+		})
+	err := loop.Catch(func(err error) Enumerator[int] {
+		Println(err)
+		return Empty[int]()
+	}).ForEachErr(func(i int) error {
+		Println(i)
+		return nil
+	})
+	Println(err)
+	// Output:
+	// fetch ""
+	// 0
+	// 1
+	// 2
+	// fetch "3"
+	// 3
+	// 4
+	// 5
+	// context canceled
+	// <nil>
+}
+
+func ExampleFromBodyLines() {
+	body := io.NopCloser(strings.NewReader("line 1\nline 2\nline 3\n"))
+	x := FromBodyLines(context.Background(), body).Take(2)
+	Printf("%q\n", x.ToSlice())
+
+	// A body which never ends
+	r, w := io.Pipe()
+	go func() {
+		for i := 1; ; i++ {
+			if _, err := Fprintf(w, "tick %d\n", i); err != nil {
+				return
+			}
+		}
+	}()
+	ctx, cancel := context.WithCancel(context.Background())
+	loop := FromBodyLines(ctx, r).Tap(func(s string) {
+		if s == "tick 3" {
+			cancel()
+		}
+	})
+	y := loop.Catch(func(err error) Enumerator[string] {
+		return From([]string{err.Error()})
+	})
+	Printf("%q\n", y.ToSlice())
+	// Output:
+	// ["line 1" "line 2"]
+	// ["tick 1" "tick 2" "tick 3" "context canceled"]
+}
+
+func ExampleFromSSE() {
+	stream := strings.NewReader(`: a comment
+
+data: hello
+
+event: update
+id: 42
+data: {"x": 1,
+data: "y": 2}
+
+retry: 3000
+data: bye
+
+`)
+	FromSSE(stream)(func(e SSEEvent) {
+		Printf("%s %q %q %d\n", e.Type, e.Data, e.ID, e.Retry)
+	})
+
+	// The last event is discarded since no blank line terminates it.
+	truncated := strings.NewReader("data: one\n\ndata: two\n")
+	FromSSE(truncated)(func(e SSEEvent) {
+		Printf("%q\n", e.Data)
+	})
+	// Output:
+	// message "hello" "" 0
+	// update "{\"x\": 1,\n\"y\": 2}" "42" 0
+	// message "bye" "42" 3000
+	// "one"
+}
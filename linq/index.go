@@ -0,0 +1,114 @@
+package linq
+
+import "sort"
+
+// Index is a lookup from keys to elements built by enumerating a sequence
+// once, so that it can serve many lookups afterwards.
+type Index[K comparable, T any] struct {
+	keys   []K // in the order of the first appearance
+	groups map[K][]T
+}
+
+// ToIndex creates an Index of the elements of loop by the keys which
+// keySelector extracts.
+func ToIndex[T any, K comparable](keySelector func(T) K,
+	loop Enumerator[T]) *Index[K, T] {
+	index := &Index[K, T]{groups: make(map[K][]T)}
+	loop(func(element T) {
+		k := keySelector(element)
+		elements, ok := index.groups[k]
+		if !ok {
+			index.keys = append(index.keys, k)
+		}
+		index.groups[k] = append(elements, element)
+	})
+	return index
+}
+
+// Get returns the elements which have key, in their order in the sequence.
+// It returns nil if there is none.
+func (index *Index[K, T]) Get(key K) []T {
+	return index.groups[key]
+}
+
+// Contains reports whether some element has key.
+func (index *Index[K, T]) Contains(key K) bool {
+	_, ok := index.groups[key]
+	return ok
+}
+
+// Len returns the number of the distinct keys.
+func (index *Index[K, T]) Len() int {
+	return len(index.keys)
+}
+
+// Keys creates an Enumerator of the distinct keys in the order of their
+// first appearance in the sequence.
+func (index *Index[K, T]) Keys() Enumerator[K] {
+	return From(index.keys)
+}
+
+// OrderedIndex is an Index whose keys are ordered, supporting range
+// queries.
+type OrderedIndex[K Ordered, T any] struct {
+	Index[K, T] // The keys are sorted.
+}
+
+// ToOrderedIndex creates an OrderedIndex of the elements of loop by the
+// keys which keySelector extracts.
+func ToOrderedIndex[T any, K Ordered](keySelector func(T) K,
+	loop Enumerator[T]) *OrderedIndex[K, T] {
+	index := &OrderedIndex[K, T]{*ToIndex(keySelector, loop)}
+	keys := index.keys
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return index
+}
+
+// Keys creates an Enumerator of the distinct keys in ascending order.
+func (index *OrderedIndex[K, T]) Keys() Enumerator[K] {
+	return index.Index.Keys()
+}
+
+// search returns the position of the first key not less than key.
+func (index *OrderedIndex[K, T]) search(key K) int {
+	return sort.Search(len(index.keys), func(i int) bool {
+		return index.keys[i] >= key
+	})
+}
+
+// Range creates an Enumerator of the elements whose keys are in [from,
+// to), in ascending order of the keys.
+// It finds the first key by binary search.
+func (index *OrderedIndex[K, T]) Range(from, to K) Enumerator[T] {
+	return func(yield func(T)) {
+		for _, k := range index.keys[index.search(from):] {
+			if k >= to {
+				return
+			}
+			for _, element := range index.groups[k] {
+				yield(element)
+			}
+		}
+	}
+}
+
+// Floor returns the greatest key less than or equal to key.
+func (index *OrderedIndex[K, T]) Floor(key K) Optional[K] {
+	i := index.search(key)
+	if i < len(index.keys) && index.keys[i] == key {
+		return Some(key)
+	}
+	if i == 0 {
+		return None[K]()
+	}
+	return Some(index.keys[i-1])
+}
+
+// Ceiling returns the least key greater than or equal to key.
+func (index *OrderedIndex[K, T]) Ceiling(key K) Optional[K] {
+	i := index.search(key)
+	if i == len(index.keys) {
+		return None[K]()
+	}
+	return Some(index.keys[i])
+}
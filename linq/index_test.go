@@ -0,0 +1,36 @@
+package linq
+
+import (
+	. "fmt"
+	"strings"
+)
+
+func ExampleToIndex() {
+	words := From(strings.Fields("go linq gopher lisp java golang"))
+	index := ToIndex(func(s string) byte { return s[0] }, words)
+	Println(index.Get('g'), index.Get('l'), index.Get('x'))
+	Println(index.Len(), index.Contains('j'))
+	Println(ToString(Select(func(b byte) rune { return rune(b) },
+		index.Keys())))
+	// Output:
+	// [go gopher golang] [linq lisp] []
+	// 3 true
+	// glj
+}
+
+func ExampleToOrderedIndex() {
+	type reading struct {
+		Hour  int
+		Value float64
+	}
+	readings := From([]reading{{9, 1.5}, {13, 2.0}, {10, 0.5}, {9, 3.0},
+		{17, 1.0}})
+	index := ToOrderedIndex(func(r reading) int { return r.Hour }, readings)
+	Println(index.Keys().ToSlice())
+	Println(index.Range(9, 13).ToSlice())
+	Println(index.Floor(12), index.Ceiling(12), index.Floor(8))
+	// Output:
+	// [9 10 13 17]
+	// [{9 1.5} {9 3} {10 0.5}]
+	// Some(10) Some(13) None
+}
@@ -0,0 +1,109 @@
+// Package interop adapts linq.Enumerator to and from the other styles of
+// iteration: iter.Seq and iter.Seq2 (with Go 1.23 or later), channels of
+// results, Next() (T, bool) iterators and cursors such as bufio.Scanner,
+// so that pipelines can be plugged into code written against them without
+// manual glue.
+package interop
+
+import "github.com/nukata/linq-in-go/linq"
+
+// Result is an element or an error sent through a channel.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// FromResults creates an Enumerator which yields the values received from
+// ch until it is closed.
+// At a Result with a non-nil Err, the enumerator panics with the error as
+// the other sources of linq do.
+func FromResults[T any](ch <-chan Result[T]) linq.Enumerator[T] {
+	return func(yield func(T)) {
+		for r := range ch {
+			if r.Err != nil {
+				panic(r.Err)
+			}
+			yield(r.Value)
+		}
+	}
+}
+
+// ToResults creates a channel to which a new goroutine sends the elements
+// of loop as Results.
+// If loop panics with an error value, the error is sent as the last
+// Result.
+// The channel is closed at the end of loop.
+// The goroutine remains until loop runs out, unless the channel is drained.
+func ToResults[T any](loop linq.Enumerator[T]) <-chan Result[T] {
+	ch := make(chan Result[T])
+	go func() {
+		defer close(ch)
+		linq.Materialize(loop)(func(n linq.Notification[T]) {
+			switch n.Kind {
+			case linq.OnNext:
+				ch <- Result[T]{Value: n.Value}
+			case linq.OnError:
+				ch <- Result[T]{Err: n.Err}
+			}
+		})
+	}()
+	return ch
+}
+
+// Iterator is the common shape of iterators which are pulled one element
+// at a time.
+// Next returns false as the second value after the last element.
+type Iterator[T any] interface {
+	Next() (T, bool)
+}
+
+// FromIterator creates an Enumerator which yields the elements pulled from
+// it until Next returns false.
+// Note that the enumerator can be enumerated only once unless it can be
+// reset.
+func FromIterator[T any](it Iterator[T]) linq.Enumerator[T] {
+	return func(yield func(T)) {
+		for {
+			element, ok := it.Next()
+			if !ok {
+				return
+			}
+			yield(element)
+		}
+	}
+}
+
+// IteratorFunc is an Iterator made of a function.
+type IteratorFunc[T any] func() (T, bool)
+
+// Next calls f.
+func (f IteratorFunc[T]) Next() (T, bool) {
+	return f()
+}
+
+// ToIterator creates an Iterator which pulls the elements of loop
+// enumerated in another goroutine, and stop, which terminates the
+// enumeration; see linq.Pull.
+// If loop panics, Next will panic with the same value.
+func ToIterator[T any](loop linq.Enumerator[T]) (it Iterator[T],
+	stop func()) {
+	next, stop := linq.Pull(loop)
+	return IteratorFunc[T](next), stop
+}
+
+// FromCursor creates an Enumerator from a cursor such as bufio.Scanner,
+// e.g. FromCursor(s.Scan, s.Text, s.Err).
+// It calls next to advance the cursor and value to get the current
+// element until next returns false.
+// Then, if err returns a non-nil error, the enumerator panics with it.
+func FromCursor[T any](next func() bool, value func() T,
+	err func() error) linq.Enumerator[T] {
+	return func(yield func(T)) {
+		for next() {
+			yield(value())
+		}
+		if e := err(); e != nil {
+			panic(e)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package interop_test
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nukata/linq-in-go/linq"
+	"github.com/nukata/linq-in-go/linq/interop"
+)
+
+func ExampleFromResults() {
+	ch := make(chan interop.Result[int], 3)
+	ch <- interop.Result[int]{Value: 1}
+	ch <- interop.Result[int]{Value: 2}
+	ch <- interop.Result[int]{Err: errors.New("broken")}
+	close(ch)
+	x := linq.Materialize(interop.FromResults(ch))
+	fmt.Println(x.ToSlice())
+	// Output: [OnNext(1) OnNext(2) OnError(broken)]
+}
+
+func ExampleToResults() {
+	loop := linq.Range(1, 2).Concat(func(yield func(int)) {
+		panic(errors.New("broken"))
+	})
+	for r := range interop.ToResults(loop) {
+		fmt.Println(r.Value, r.Err)
+	}
+	// Output:
+	// 1 <nil>
+	// 2 <nil>
+	// 0 broken
+}
+
+// countdown is an Iterator written in another style.
+type countdown struct {
+	n int
+}
+
+func (c *countdown) Next() (int, bool) {
+	if c.n == 0 {
+		return 0, false
+	}
+	c.n--
+	return c.n + 1, true
+}
+
+func ExampleFromIterator() {
+	x := interop.FromIterator[int](&countdown{3})
+	fmt.Println(x.ToSlice())
+	// Output: [3 2 1]
+}
+
+func ExampleToIterator() {
+	it, stop := interop.ToIterator(linq.Range(1, 1<<62))
+	defer stop()
+	for i := 0; i < 3; i++ {
+		x, ok := it.Next()
+		fmt.Println(x, ok)
+	}
+	// Output:
+	// 1 true
+	// 2 true
+	// 3 true
+}
+
+func ExampleFromCursor() {
+	s := bufio.NewScanner(strings.NewReader("one line\nanother line\n"))
+	s.Split(bufio.ScanWords)
+	x := interop.FromCursor(s.Scan, s.Text, s.Err)
+	fmt.Println(x.ToSlice())
+	// Output: [one line another line]
+}
@@ -0,0 +1,42 @@
+//go:build go1.23
+
+package interop
+
+import (
+	"iter"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+// FromSeq creates an Enumerator from an iter.Seq.
+func FromSeq[T any](seq iter.Seq[T]) linq.Enumerator[T] {
+	return linq.Seq[T](seq).Enumerator()
+}
+
+// ToSeq creates an iter.Seq from an Enumerator, which can be ranged over
+// with for.
+// If the loop body breaks, the enumeration of loop is terminated.
+func ToSeq[T any](loop linq.Enumerator[T]) iter.Seq[T] {
+	return iter.Seq[T](loop.Seq())
+}
+
+// FromSeq2 creates an Enumerator of pairs from an iter.Seq2.
+func FromSeq2[K any, V any](
+	seq iter.Seq2[K, V]) linq.Enumerator[linq.Pair[K, V]] {
+	return FromSeq(func(yield func(linq.Pair[K, V]) bool) {
+		seq(func(k K, v V) bool {
+			return yield(linq.MakePair(k, v))
+		})
+	})
+}
+
+// ToSeq2 creates an iter.Seq2 from an Enumerator of pairs.
+func ToSeq2[K any, V any](
+	loop linq.Enumerator[linq.Pair[K, V]]) iter.Seq2[K, V] {
+	seq := loop.Seq()
+	return func(yield func(K, V) bool) {
+		seq(func(p linq.Pair[K, V]) bool {
+			return yield(p.First, p.Second)
+		})
+	}
+}
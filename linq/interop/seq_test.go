@@ -0,0 +1,38 @@
+//go:build go1.23
+
+package interop_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/nukata/linq-in-go/linq"
+	"github.com/nukata/linq-in-go/linq/interop"
+)
+
+func ExampleFromSeq() {
+	x := interop.FromSeq(slices.Values([]int{3, 1, 2}))
+	fmt.Println(x.Take(2).ToSlice())
+	// Output: [3 1]
+}
+
+func ExampleToSeq() {
+	evens := linq.Range(1, 10).Where(func(x int) bool { return x%2 == 0 })
+	fmt.Println(slices.Collect(interop.ToSeq(evens)))
+	// Output: [2 4 6 8 10]
+}
+
+func ExampleFromSeq2() {
+	x := interop.FromSeq2(maps.All(map[string]int{"a": 1, "b": 2}))
+	fmt.Println(x.OrderBy(func(p, q linq.Pair[string, int]) bool {
+		return p.First < q.First
+	}).ToSlice())
+	// Output: [{a 1} {b 2}]
+}
+
+func ExampleToSeq2() {
+	x := linq.ZipToPairs(linq.From([]string{"a", "b"}), linq.Range(1, 2))
+	fmt.Println(maps.Collect(interop.ToSeq2(x)))
+	// Output: map[a:1 b:2]
+}
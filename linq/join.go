@@ -0,0 +1,128 @@
+package linq
+
+// toLookup creates a map from each key to the elements of loop which have
+// the key, preserving their order in loop.
+func toLookup[T any, K comparable](key func(T) K,
+	loop Enumerator[T]) map[K][]T {
+	result := make(map[K][]T)
+	loop(func(element T) {
+		k := key(element)
+		result[k] = append(result[k], element)
+	})
+	return result
+}
+
+// Join creates an Enumerator which correlates the elements of outer and
+// inner based on matching keys and applies f to each pair.
+// The outer elements with no matching inner elements are skipped.
+// The inner sequence is enumerated once each time the outer is enumerated.
+func Join[T any, U any, K comparable, R any](outerKey func(T) K,
+	innerKey func(U) K, f func(T, U) R,
+	outer Enumerator[T], inner Enumerator[U]) Enumerator[R] {
+	return func(yield func(R)) {
+		lookup := toLookup(innerKey, inner)
+		outer(func(element T) {
+			for _, element2 := range lookup[outerKey(element)] {
+				yield(f(element, element2))
+			}
+		})
+	}
+}
+
+// LeftJoin creates an Enumerator which correlates the elements of outer
+// and inner based on matching keys and applies f to each pair.
+// An outer element with no matching inner elements is paired with the zero
+// value of U.
+// The inner sequence is enumerated once each time the outer is enumerated.
+func LeftJoin[T any, U any, K comparable, R any](outerKey func(T) K,
+	innerKey func(U) K, f func(T, U) R,
+	outer Enumerator[T], inner Enumerator[U]) Enumerator[R] {
+	return func(yield func(R)) {
+		lookup := toLookup(innerKey, inner)
+		outer(func(element T) {
+			matches, ok := lookup[outerKey(element)]
+			if !ok {
+				var zero U
+				yield(f(element, zero))
+				return
+			}
+			for _, element2 := range matches {
+				yield(f(element, element2))
+			}
+		})
+	}
+}
+
+// FullOuterJoin creates an Enumerator which correlates the elements of
+// outer and inner based on matching keys and applies f to each pair.
+// An element with no matching elements in the other sequence is paired with
+// the zero value.
+// The unmatched inner elements are yielded after all the outer elements
+// in the order of inner.
+func FullOuterJoin[T any, U any, K comparable, R any](outerKey func(T) K,
+	innerKey func(U) K, f func(T, U) R,
+	outer Enumerator[T], inner Enumerator[U]) Enumerator[R] {
+	return func(yield func(R)) {
+		var keys []K // the keys of inner in the order of their appearance
+		lookup := make(map[K][]U)
+		inner(func(element U) {
+			k := innerKey(element)
+			if _, ok := lookup[k]; !ok {
+				keys = append(keys, k)
+			}
+			lookup[k] = append(lookup[k], element)
+		})
+		matched := make(map[K]bool)
+		outer(func(element T) {
+			k := outerKey(element)
+			matches, ok := lookup[k]
+			if !ok {
+				var zero U
+				yield(f(element, zero))
+				return
+			}
+			matched[k] = true
+			for _, element2 := range matches {
+				yield(f(element, element2))
+			}
+		})
+		var zero T
+		for _, k := range keys {
+			if !matched[k] {
+				for _, element2 := range lookup[k] {
+					yield(f(zero, element2))
+				}
+			}
+		}
+	}
+}
+
+// JoinMap creates an Enumerator which looks up the key of each element of
+// loop in table and applies f to the element and the value, e.g. to
+// enrich events with reference data already in memory.
+// The elements whose keys are not in table are skipped; see LeftJoinMap to
+// keep them.
+// Unlike Join, it needs no second sequence to build a lookup from.
+func JoinMap[T any, K comparable, V any, R any](key func(T) K,
+	f func(T, V) R, table map[K]V, loop Enumerator[T]) Enumerator[R] {
+	return func(yield func(R)) {
+		loop(func(element T) {
+			if v, ok := table[key(element)]; ok {
+				yield(f(element, v))
+			}
+		})
+	}
+}
+
+// LeftJoinMap is a variant of JoinMap which keeps the elements whose keys
+// are not in table.
+// f is called with the zero value of V and false for such elements.
+func LeftJoinMap[T any, K comparable, V any, R any](key func(T) K,
+	f func(T, V, bool) R, table map[K]V, loop Enumerator[T]) Enumerator[R] {
+	return func(yield func(R)) {
+		loop(func(element T) {
+			v, ok := table[key(element)]
+			yield(f(element, v, ok))
+		})
+	}
+}
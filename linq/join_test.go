@@ -0,0 +1,86 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+type joinPerson struct {
+	Name string
+}
+
+type joinPet struct {
+	Name  string
+	Owner string
+}
+
+var (
+	joinPeople = []joinPerson{{"Hedlund"}, {"Adams"}, {"Weiss"}}
+	joinPets   = []joinPet{
+		{"Barley", "Adams"}, {"Boots", "Adams"},
+		{"Whiskers", "Weiss"}, {"Daisy", "Ito"},
+	}
+)
+
+func ExampleJoin() {
+	x := Join(func(p joinPerson) string { return p.Name },
+		func(p joinPet) string { return p.Owner },
+		func(person joinPerson, pet joinPet) string {
+			return person.Name + " - " + pet.Name
+		}, From(joinPeople), From(joinPets))
+	x(func(s string) { Printf("%q\n", s) })
+	// Output:
+	// "Adams - Barley"
+	// "Adams - Boots"
+	// "Weiss - Whiskers"
+}
+
+func ExampleLeftJoin() {
+	x := LeftJoin(func(p joinPerson) string { return p.Name },
+		func(p joinPet) string { return p.Owner },
+		func(person joinPerson, pet joinPet) string {
+			return person.Name + " - " + pet.Name
+		}, From(joinPeople), From(joinPets))
+	x(func(s string) { Printf("%q\n", s) })
+	// Output:
+	// "Hedlund - "
+	// "Adams - Barley"
+	// "Adams - Boots"
+	// "Weiss - Whiskers"
+}
+
+func ExampleFullOuterJoin() {
+	x := FullOuterJoin(func(p joinPerson) string { return p.Name },
+		func(p joinPet) string { return p.Owner },
+		func(person joinPerson, pet joinPet) string {
+			return person.Name + " - " + pet.Name
+		}, From(joinPeople), From(joinPets))
+	x(func(s string) { Printf("%q\n", s) })
+	// Output:
+	// "Hedlund - "
+	// "Adams - Barley"
+	// "Adams - Boots"
+	// "Weiss - Whiskers"
+	// " - Daisy"
+}
+
+func ExampleJoinMap() {
+	names := map[int]string{1: "alice", 2: "bob"}
+	orders := From([][2]int{{1, 300}, {3, 50}, {2, 120}, {1, 20}})
+	customer := func(o [2]int) int { return o[0] }
+
+	x := JoinMap(customer, func(o [2]int, name string) string {
+		return Sprint(name, ":", o[1])
+	}, names, orders)
+	Println(x.ToSlice())
+
+	y := LeftJoinMap(customer, func(o [2]int, name string, ok bool) string {
+		if !ok {
+			name = "unknown"
+		}
+		return Sprint(name, ":", o[1])
+	}, names, orders)
+	Println(y.ToSlice())
+	// Output:
+	// [alice:300 bob:120 alice:20]
+	// [alice:300 unknown:50 bob:120 alice:20]
+}
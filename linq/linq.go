@@ -10,6 +10,10 @@ import (
 	"bufio"
 	"container/list"
 	"io"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
 )
 
 // Enumerator represents a sequence abstractly.
@@ -27,17 +31,24 @@ func (loop Enumerator[T]) ToList() *list.List {
 }
 
 // ToSlice creates a slice from the sequence which Enumerator represents.
+// See Sized for sequences whose lengths are known in advance.
 func (loop Enumerator[T]) ToSlice() []T {
-	lst := loop.ToList()
-	result := make([]T, lst.Len())
-	i := 0
-	for element := lst.Front(); element != nil; element = element.Next() {
-		result[i] = element.Value.(T)
-		i++
-	}
+	result := []T{}
+	loop(func(element T) {
+		result = append(result, element)
+	})
 	return result
 }
 
+// Count returns the number of elements in the sequence.
+func (loop Enumerator[T]) Count() int {
+	n := 0
+	loop(func(T) {
+		n++
+	})
+	return n
+}
+
 // Aggregate applies the binary function f to seed with each of elements
 // e1, e2, ..., eN from loop, resulting in
 // f(f(...f(f(seed, e1), e2), ...), eN).
@@ -48,6 +59,30 @@ func Aggregate[S any, T any](f func(S, T) S, seed S, loop Enumerator[T]) S {
 	return seed
 }
 
+// AggregateR is a variant of Aggregate.
+// It applies resultSelector to the final accumulator value.
+func AggregateR[S any, T any, R any](f func(S, T) S, seed S,
+	resultSelector func(S) R, loop Enumerator[T]) R {
+	return resultSelector(Aggregate(f, seed, loop))
+}
+
+// Reduce applies the binary function f to each of elements e1, e2, ..., eN
+// of the sequence, resulting in f(f(...f(e1, e2), ...), eN).
+// It returns false as the second value if the sequence is empty.
+func (loop Enumerator[T]) Reduce(f func(T, T) T) (T, bool) {
+	var result T
+	found := false
+	loop(func(element T) {
+		if found {
+			result = f(result, element)
+		} else {
+			result = element
+			found = true
+		}
+	})
+	return result, found
+}
+
 // AggregateWithExit is a variant of Aggregate.
 // It supplies an "exit" argument to the function f.
 // If f calls exit(x), the enumeration will terminate and x will be returned.
@@ -85,6 +120,72 @@ func (loop Enumerator[T]) LoopWithExit(f func(T, func())) {
 	})
 }
 
+// ForEach calls action for each element of Enumerator.
+func (loop Enumerator[T]) ForEach(action func(T)) {
+	loop(action)
+}
+
+// ForEachI calls action(index, element) for each element of Enumerator,
+// where index counts from 0.
+func (loop Enumerator[T]) ForEachI(action func(int, T)) {
+	i := 0
+	loop(func(element T) {
+		action(i, element)
+		i++
+	})
+}
+
+// ForEachErr calls action for each element of Enumerator.
+// If action returns a non-nil error, the enumeration will terminate and
+// the error will be returned.
+func (loop Enumerator[T]) ForEachErr(action func(T) error) error {
+	var err error
+	loop.LoopWithExit(func(element T, exit func()) {
+		if err = action(element); err != nil {
+			exit()
+		}
+	})
+	return err
+}
+
+// FindIndex returns the index of the first element which satisfies
+// predicate, or -1 if there is none.
+// It stops the enumeration at the element.
+func (loop Enumerator[T]) FindIndex(predicate func(T) bool) int {
+	i, found := 0, false
+	loop.LoopWithExit(func(element T, exit func()) {
+		if predicate(element) {
+			found = true
+			exit()
+			return
+		}
+		i++
+	})
+	if !found {
+		return -1
+	}
+	return i
+}
+
+// FindLastIndex returns the index of the last element which satisfies
+// predicate, or -1 if there is none.
+func (loop Enumerator[T]) FindLastIndex(predicate func(T) bool) int {
+	i, last := 0, -1
+	loop(func(element T) {
+		if predicate(element) {
+			last = i
+		}
+		i++
+	})
+	return last
+}
+
+// IndexOf returns the index of the first occurrence of value in loop,
+// or -1 if there is none.
+func IndexOf[T comparable](value T, loop Enumerator[T]) int {
+	return loop.FindIndex(func(element T) bool { return element == value })
+}
+
 // Select creates an Enumerator which applies f to each of elements.
 func Select[T any, R any](f func(T) R, loop Enumerator[T]) Enumerator[R] {
 	return func(yield func(R)) {
@@ -121,6 +222,47 @@ func (loop Enumerator[T]) Where(predicate func(T) bool) Enumerator[T] {
 	}
 }
 
+// Tap creates an Enumerator which applies action to each of elements
+// and yields the element unchanged.
+// It is useful for logging or debugging in the middle of a sequence of
+// operators.
+func (loop Enumerator[T]) Tap(action func(T)) Enumerator[T] {
+	return func(yield func(T)) {
+		loop(func(element T) {
+			action(element)
+			yield(element)
+		})
+	}
+}
+
+// OnProgress creates an Enumerator which yields the elements of the
+// sequence unchanged and calls report(processed) every time every elements
+// have been processed, and once more with the total when the enumeration
+// completes or terminates early unless the total has just been reported.
+// Thus an empty sequence reports 0 once, telling that it has run.
+// It is useful for progress bars and heartbeat logging of long-running
+// batch processing.
+func (loop Enumerator[T]) OnProgress(every int,
+	report func(processed int64)) Enumerator[T] {
+	return func(yield func(T)) {
+		var processed int64
+		reported := int64(-1)
+		defer func() {
+			if processed != reported {
+				report(processed)
+			}
+		}()
+		loop(func(element T) {
+			processed++
+			yield(element)
+			if every > 0 && processed%int64(every) == 0 {
+				report(processed)
+				reported = processed
+			}
+		})
+	}
+}
+
 // Take creates an Enumerator which takes the first n elements from
 // the sequence.
 func (loop Enumerator[T]) Take(n int) Enumerator[T] {
@@ -184,6 +326,88 @@ func (loop Enumerator[T]) SkipWhile(predicate func(T) bool) Enumerator[T] {
 	}
 }
 
+// Slice creates an Enumerator which yields the elements whose indices are
+// from, from+step, from+2*step, ... and less than to.
+// A negative to means the end of the sequence.
+// A negative from is treated as 0, and a step less than 1 as 1.
+func (loop Enumerator[T]) Slice(from, to, step int) Enumerator[T] {
+	if from < 0 {
+		from = 0
+	}
+	if step < 1 {
+		step = 1
+	}
+	return func(yield func(T)) {
+		if to >= 0 && from >= to {
+			return
+		}
+		i := 0
+		loop.LoopWithExit(func(element T, exit func()) {
+			if i >= from && (i-from)%step == 0 {
+				yield(element)
+			}
+			i++
+			if to >= 0 && i >= to {
+				exit()
+			}
+		})
+	}
+}
+
+// EveryNth creates an Enumerator which yields every n-th element, i.e.
+// the elements whose indices are 0, n, 2*n, ...
+func (loop Enumerator[T]) EveryNth(n int) Enumerator[T] {
+	return loop.Slice(0, -1, n)
+}
+
+// PadEnd creates an Enumerator which yields the elements of the sequence
+// followed by fill as many times as needed to yield at least length
+// elements.
+func (loop Enumerator[T]) PadEnd(length int, fill T) Enumerator[T] {
+	return func(yield func(T)) {
+		n := 0
+		loop(func(element T) {
+			yield(element)
+			n++
+		})
+		for ; n < length; n++ {
+			yield(fill)
+		}
+	}
+}
+
+// PadStart creates an Enumerator which yields fill as many times as needed
+// to yield at least length elements, followed by the elements of the
+// sequence.
+// It buffers up to length elements.
+func (loop Enumerator[T]) PadStart(length int, fill T) Enumerator[T] {
+	return func(yield func(T)) {
+		var head []T
+		flushed := length <= 0
+		loop(func(element T) {
+			if flushed {
+				yield(element)
+				return
+			}
+			head = append(head, element)
+			if len(head) == length {
+				for _, e := range head {
+					yield(e)
+				}
+				flushed = true
+			}
+		})
+		if !flushed {
+			for i := len(head); i < length; i++ {
+				yield(fill)
+			}
+			for _, e := range head {
+				yield(e)
+			}
+		}
+	}
+}
+
 // Concat concatenates two Enumerators loop and loop2.
 func (loop Enumerator[T]) Concat(loop2 Enumerator[T]) Enumerator[T] {
 	return func(yield func(T)) {
@@ -195,6 +419,43 @@ func (loop Enumerator[T]) Concat(loop2 Enumerator[T]) Enumerator[T] {
 	}
 }
 
+// Reverse creates an Enumerator which yields the elements of the sequence
+// in reverse order.
+// It buffers all the elements each time it is enumerated.
+func (loop Enumerator[T]) Reverse() Enumerator[T] {
+	return func(yield func(T)) {
+		buffered(loop, func(x []T) {
+			reverse(x, yield)
+		})
+	}
+}
+
+func reverse[T any](x []T, yield func(T)) {
+	for i := len(x) - 1; i >= 0; i-- {
+		yield(x[i])
+	}
+}
+
+// Shuffle creates an Enumerator which yields the elements of the sequence
+// in random order chosen by r.
+// It buffers all the elements each time it is enumerated.
+func (loop Enumerator[T]) Shuffle(r *rand.Rand) Enumerator[T] {
+	return func(yield func(T)) {
+		buffered(loop, func(x []T) {
+			shuffle(r, x, yield)
+		})
+	}
+}
+
+func shuffle[T any](r *rand.Rand, x []T, yield func(T)) {
+	r.Shuffle(len(x), func(i, j int) {
+		x[i], x[j] = x[j], x[i]
+	})
+	for _, element := range x {
+		yield(element)
+	}
+}
+
 // Zip creates an Enumerator which enumerates loop1 and loop2 in step,
 // applying f to each element pair.
 func Zip[T any, U any, R any](f func(T, U) R,
@@ -247,6 +508,94 @@ func Range(start, count int) Enumerator[int] {
 	}
 }
 
+// RangeStep creates an Enumerator which yields count integers
+// start, start+step, start+2*step, ...
+func RangeStep(start, count, step int) Enumerator[int] {
+	return func(yield func(int)) {
+		for i := 0; i < count; i++ {
+			yield(start + i*step)
+		}
+	}
+}
+
+// RangeTo creates an Enumerator which counts from start up to end-1.
+func RangeTo(start, end int) Enumerator[int] {
+	return func(yield func(int)) {
+		for i := start; i < end; i++ {
+			yield(i)
+		}
+	}
+}
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	Integer | ~float32 | ~float64
+}
+
+// Ordered is a constraint that permits any type which supports the
+// operators < <= >= >.
+type Ordered interface {
+	Number | ~string
+}
+
+// RangeBy creates an Enumerator which yields start, start+step,
+// start+2*step, ... while they are less than end if step is positive,
+// or greater than end if step is negative.
+// It yields nothing if step is zero.
+// Each element is computed as start+i*step so that floating-point errors
+// do not accumulate.
+// The enumeration stops if the next element overflows.
+func RangeBy[T Number](start, end, step T) Enumerator[T] {
+	return func(yield func(T)) {
+		var zero T
+		switch {
+		case step > zero:
+			for i, x := T(1), start; x < end; i++ {
+				yield(x)
+				next := start + i*step
+				if next <= x { // overflow
+					break
+				}
+				x = next
+			}
+		case step < zero:
+			for i, x := T(1), start; x > end; i++ {
+				yield(x)
+				next := start + i*step
+				if next >= x { // overflow
+					break
+				}
+				x = next
+			}
+		}
+	}
+}
+
+// Linspace creates an Enumerator which yields n evenly spaced numbers
+// from start to stop inclusive.
+// It yields only start if n is 1.
+func Linspace(start, stop float64, n int) Enumerator[float64] {
+	return func(yield func(float64)) {
+		if n == 1 {
+			yield(start)
+			return
+		}
+		step := (stop - start) / float64(n-1)
+		for i := 0; i < n-1; i++ {
+			yield(start + float64(i)*step)
+		}
+		if n > 1 {
+			yield(stop)
+		}
+	}
+}
+
 // Repeat creates an Enumerator which repeats element count times.
 func Repeat[T any](element T, count int) Enumerator[T] {
 	return func(yield func(T)) {
@@ -292,6 +641,43 @@ func FromString[S ~string](x S) Enumerator[rune] {
 	}
 }
 
+// FromBytes creates an Enumerator[byte] from a byte slice.
+func FromBytes[B ~[]byte](x B) Enumerator[byte] {
+	return func(yield func(byte)) {
+		for _, b := range x {
+			yield(b)
+		}
+	}
+}
+
+// FromStringBytes creates an Enumerator[byte] which yields each byte of
+// a string, while FromString yields each rune.
+func FromStringBytes[S ~string](x S) Enumerator[byte] {
+	return func(yield func(byte)) {
+		for i := 0; i < len(x); i++ {
+			yield(x[i])
+		}
+	}
+}
+
+// ToString creates a string from the sequence of runes.
+func ToString(loop Enumerator[rune]) string {
+	var sb strings.Builder
+	loop(func(c rune) {
+		sb.WriteRune(c)
+	})
+	return sb.String()
+}
+
+// ToBytes creates a byte slice from the sequence of bytes.
+func ToBytes(loop Enumerator[byte]) []byte {
+	result := []byte{}
+	loop(func(b byte) {
+		result = append(result, b)
+	})
+	return result
+}
+
 // FromList[T] creats an Enumerator[T] from a list.List.
 func FromList[T any](x *list.List) Enumerator[T] {
 	return func(yield func(T)) {
@@ -301,12 +687,52 @@ func FromList[T any](x *list.List) Enumerator[T] {
 	}
 }
 
+// FromMap creates an Enumerator of the entries of a map as Pairs of keys
+// and values.
+// The order of the entries is unspecified and may differ between
+// enumerations; see FromMapSorted.
+func FromMap[M ~map[K]V, K comparable, V any](m M) Enumerator[Pair[K, V]] {
+	return func(yield func(Pair[K, V])) {
+		for k, v := range m {
+			yield(Pair[K, V]{k, v})
+		}
+	}
+}
+
+// FromMapSorted creates an Enumerator of the entries of a map as Pairs of
+// keys and values in ascending order of the keys, so that the results are
+// reproducible, e.g. for reports and golden tests.
+// It sorts a copy of the keys each time it is enumerated.
+func FromMapSorted[M ~map[K]V, K Ordered, V any](m M) Enumerator[Pair[K, V]] {
+	return func(yield func(Pair[K, V])) {
+		keys := make([]K, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+		for _, k := range keys {
+			yield(Pair[K, V]{k, m[k]})
+		}
+	}
+}
+
 // FromReader creats an Enumerator[string] from an io.Reader.
 // The enumerator will yield each line of scanner.Text() and may panic with
 // scanner.Err().
 func FromReader(x io.Reader) Enumerator[string] {
 	return func(yield func(string)) {
-		scanner := bufio.NewScanner(x)
+		FromScanner(bufio.NewScanner(x))(yield)
+	}
+}
+
+// FromScanner creates an Enumerator[string] from a bufio.Scanner, which
+// the caller can configure with Buffer and Split in advance.
+// The enumerator will yield each token of scanner.Text() and may panic with
+// scanner.Err().
+// Since the scanner is consumed, the enumerator yields nothing when it is
+// enumerated again.
+func FromScanner(scanner *bufio.Scanner) Enumerator[string] {
+	return func(yield func(string)) {
 		for scanner.Scan() {
 			yield(scanner.Text())
 		}
@@ -315,3 +741,34 @@ func FromReader(x io.Reader) Enumerator[string] {
 		}
 	}
 }
+
+// Using creates an Enumerator which calls open to get a resource each time
+// it is enumerated and yields the elements of body(resource).
+// The resource will be closed when the enumeration completes or terminates
+// early, e.g. by Take.
+// If open returns an error, the enumerator will panic with it.
+// The error from Close is ignored.
+func Using[R io.Closer, T any](open func() (R, error),
+	body func(R) Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		resource, err := open()
+		if err != nil {
+			panic(err)
+		}
+		defer resource.Close()
+		body(resource)(yield)
+	}
+}
+
+// FromFileLines creates an Enumerator[string] which opens the file named
+// path each time it is enumerated and yields each line of the file.
+// The file will be closed when the enumeration completes or terminates
+// early, e.g. by Take.
+// The enumerator may panic with the error from os.Open or scanner.Err().
+func FromFileLines(path string) Enumerator[string] {
+	return Using(func() (*os.File, error) {
+		return os.Open(path)
+	}, func(f *os.File) Enumerator[string] {
+		return FromReader(f)
+	})
+}
@@ -3,10 +3,14 @@
 package linq
 
 import (
+	"bufio"
 	"container/list"
 	"errors"
 	. "fmt"
+	"math/rand"
+	"os"
 	"strings"
+	"testing"
 )
 
 // Generate a sequence of integers from 1 to 10.
@@ -76,6 +80,13 @@ func ExampleEnumerator_ToSlice() {
 	// [a b c]
 }
 
+func ExampleEnumerator_Count() {
+	n := Range(1, 10).Where(func(e int) bool { return e%3 == 0 }).Count()
+	Println(n)
+	// Output:
+	// 3
+}
+
 func ExampleAggregate() {
 	x := Aggregate(func(a, b int) int { return a * b }, 100, Range(1, 5))
 	// x = 100 * 1 * 2 * 3 * 4 * 5
@@ -84,6 +95,30 @@ func ExampleAggregate() {
 	// 12000
 }
 
+func ExampleAggregateR() {
+	words := From([]string{"apple", "mango", "orange", "passionfruit"})
+	x := AggregateR(func(longest, next string) string {
+		if len(next) > len(longest) {
+			return next
+		}
+		return longest
+	}, "banana", strings.ToUpper, words)
+	Println(x)
+	// Output:
+	// PASSIONFRUIT
+}
+
+func ExampleEnumerator_Reduce() {
+	x, ok := Range(1, 5).Reduce(func(a, b int) int { return a * b })
+	Println(x, ok)
+
+	y, ok := Empty[int]().Reduce(func(a, b int) int { return a * b })
+	Println(y, ok)
+	// Output:
+	// 120 true
+	// 0 false
+}
+
 func ExampleAggregateWithExit() {
 	seq := From([]any{1, 2, 3, errors.New("poi"), 4, 5})
 	x := AggregateWithExit(func(a int, b any, exit func(int)) int {
@@ -120,6 +155,64 @@ func ExampleEnumerator_LoopWithExit() {
 	// ---
 }
 
+func ExampleEnumerator_ForEach() {
+	From([]string{"Funa", "1-hachi"}).ForEach(func(s string) {
+		Println(s)
+	})
+	// Output:
+	// Funa
+	// 1-hachi
+}
+
+func ExampleEnumerator_ForEachI() {
+	FromString("abc").ForEachI(func(i int, c rune) {
+		Printf("%d: %c\n", i, c)
+	})
+	// Output:
+	// 0: a
+	// 1: b
+	// 2: c
+}
+
+func ExampleEnumerator_ForEachErr() {
+	err := IntsFrom(1).ForEachErr(func(i int) error {
+		if i > 3 {
+			return Errorf("%d is too large", i)
+		}
+		Println(i)
+		return nil
+	})
+	Println(err)
+	// Output:
+	// 1
+	// 2
+	// 3
+	// 4 is too large
+}
+
+func ExampleEnumerator_FindIndex() {
+	Println(IntsFrom(1).FindIndex(func(i int) bool { return i*i > 50 }))
+	Println(Range(1, 5).FindIndex(func(i int) bool { return i > 5 }))
+	// Output:
+	// 7
+	// -1
+}
+
+func ExampleEnumerator_FindLastIndex() {
+	x := From([]int{3, 1, 4, 1, 5, 9})
+	Println(x.FindLastIndex(func(i int) bool { return i < 4 }))
+	// Output:
+	// 3
+}
+
+func ExampleIndexOf() {
+	Println(IndexOf('l', FromString("hello")))
+	Println(IndexOf("z", From([]string{"x", "y"})))
+	// Output:
+	// 2
+	// -1
+}
+
 func ExampleSelect() {
 	seq := Select(func(e int) int { return e + 100 }, From([]int{7, 8, 9}))
 	seq(func(e int) {
@@ -156,6 +249,33 @@ func ExampleEnumerator_Where() {
 	// [2 4 6 8 10]
 }
 
+func ExampleEnumerator_Tap() {
+	x := Range(1, 6).Tap(func(e int) {
+		Print(e, "-")
+	}).Where(func(e int) bool { return e%2 == 0 }).Take(2)
+	Printf("\n%v\n", x.ToSlice())
+	// Output:
+	// 1-2-3-4-
+	// [2 4]
+}
+
+func ExampleEnumerator_OnProgress() {
+	report := func(n int64) { Println("processed", n) }
+	Println(Range(1, 25).OnProgress(10, report).Count())
+	Println(IntsFrom(1).OnProgress(2, report).Take(3).Count())
+	Println(Empty[int]().OnProgress(10, report).Count())
+	// Output:
+	// processed 10
+	// processed 20
+	// processed 25
+	// 25
+	// processed 2
+	// processed 3
+	// 3
+	// processed 0
+	// 0
+}
+
 func ExampleEnumerator_Take() {
 	x := Range(1, 6).Take(3)
 	Printf("%v\n", x.ToSlice())
@@ -184,6 +304,54 @@ func ExampleEnumerator_SkipWhile() {
 	// [4 5 6]
 }
 
+func ExampleEnumerator_Slice() {
+	x := IntsFrom(0).Slice(2, 10, 3)
+	Printf("%v\n", x.ToSlice())
+
+	y := Range(0, 10).Slice(7, -1, 1)
+	Printf("%v\n", y.ToSlice())
+
+	// A negative from is treated as 0.
+	z := Range(0, 10).Slice(-1, 0, 1)
+	Printf("%v\n", z.ToSlice())
+	w := Range(0, 10).Slice(-2, 5, 2)
+	Printf("%v\n", w.ToSlice())
+	// Output:
+	// [2 5 8]
+	// [7 8 9]
+	// []
+	// [0 2 4]
+}
+
+func ExampleEnumerator_EveryNth() {
+	x := FromString("a-b-c-d").EveryNth(2)
+	Println(ToString(x))
+	// Output:
+	// abcd
+}
+
+func ExampleEnumerator_PadEnd() {
+	x := From([]string{"a", "b"}).PadEnd(4, "-")
+	Printf("%v\n", x.ToSlice())
+
+	y := Range(1, 5).PadEnd(3, 0)
+	Printf("%v\n", y.ToSlice())
+	// Output:
+	// [a b - -]
+	// [1 2 3 4 5]
+}
+
+func ExampleEnumerator_PadStart() {
+	x := FromString("42").PadStart(5, '0')
+	Println(ToString(x))
+
+	y := FromString("12345").PadStart(3, '0')
+	Println(ToString(y))
+	// Output:
+	// 00042
+	// 12345
+}
+
 func ExampleEnumerator_Concat() {
 	x := Range(7, 5).Concat(Range(101, 9))
 	Printf("%v\n", x.ToSlice())
@@ -191,6 +359,22 @@ func ExampleEnumerator_Concat() {
 	// [7 8 9 10 11 101 102 103 104 105 106 107 108 109]
 }
 
+func ExampleEnumerator_Reverse() {
+	x := Range(1, 5).Reverse()
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [5 4 3 2 1]
+}
+
+func ExampleEnumerator_Shuffle() {
+	r := rand.New(rand.NewSource(1))
+	x := Range(1, 5).Shuffle(r).ToSlice()
+	sum := Aggregate(func(a, b int) int { return a + b }, 0, From(x))
+	Println(len(x), sum)
+	// Output:
+	// 5 15
+}
+
 func ExampleZip() {
 	aa := From([]int{3, 1, 4, 1, 5, 9})
 	bb := From([]int{2, 7, 1, 8, 2, 8})
@@ -219,6 +403,46 @@ func ExampleEmpty() {
 	// []
 }
 
+func ExampleRangeStep() {
+	x := RangeStep(10, 4, -3)
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [10 7 4 1]
+}
+
+func ExampleRangeTo() {
+	x := RangeTo(-2, 3)
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [-2 -1 0 1 2]
+}
+
+func ExampleRangeBy() {
+	x := RangeBy(0, 10, 4)
+	Printf("%v\n", x.ToSlice())
+
+	y := RangeBy(1.0, 0, -0.25)
+	Printf("%v\n", y.ToSlice())
+
+	z := RangeBy[uint8](250, 255, 2)
+	Printf("%v\n", z.ToSlice())
+	// Output:
+	// [0 4 8]
+	// [1 0.75 0.5 0.25]
+	// [250 252 254]
+}
+
+func ExampleLinspace() {
+	x := Linspace(0, 1, 5)
+	Printf("%v\n", x.ToSlice())
+
+	y := Linspace(2, -1, 4)
+	Printf("%v\n", y.ToSlice())
+	// Output:
+	// [0 0.25 0.5 0.75 1]
+	// [2 1 0 -1]
+}
+
 func ExampleRepeat() {
 	x := Repeat("toi", 3)
 	Printf("%v\n", x.ToSlice())
@@ -273,6 +497,22 @@ func ExampleFromChan() {
 	// 2-hachi
 }
 
+func ExampleFromMap() {
+	m := map[string]int{"one": 1, "two": 2, "three": 3}
+	Println(Sum(Select(func(p Pair[string, int]) int {
+		return p.Second
+	}, FromMap(m))))
+	// Output:
+	// 6
+}
+
+func ExampleFromMapSorted() {
+	m := map[string]int{"one": 1, "two": 2, "three": 3}
+	Println(FromMapSorted(m).ToSlice())
+	// Output:
+	// [{one 1} {three 3} {two 2}]
+}
+
 func ExampleFromString() {
 	loop := FromString("2718")
 	loop(func(ch rune) { Printf("%c\n", ch) })
@@ -283,6 +523,38 @@ func ExampleFromString() {
 	// 8
 }
 
+func ExampleFromBytes() {
+	loop := FromBytes([]byte{0x47, 0x6f})
+	loop(func(b byte) { Printf("%#x\n", b) })
+	// Output:
+	// 0x47
+	// 0x6f
+}
+
+func ExampleFromStringBytes() {
+	loop := FromStringBytes("hé")
+	Printf("%v\n", loop.ToSlice())
+	// Output:
+	// [104 195 169]
+}
+
+func ExampleToString() {
+	upper := Select(func(c rune) rune {
+		return c - 'a' + 'A'
+	}, FromString("linq").Reverse())
+	Println(ToString(upper))
+	// Output:
+	// QNIL
+}
+
+func ExampleToBytes() {
+	x := ToBytes(Select(func(b byte) byte { return b + 1 },
+		FromStringBytes("HAL")))
+	Println(string(x))
+	// Output:
+	// IBM
+}
+
 func ExampleFromList() {
 	x := list.New()
 	x.PushBack("Funa")
@@ -310,6 +582,56 @@ the lazy dog.
 	// "the lazy dog."
 }
 
+func ExampleFromScanner() {
+	scanner := bufio.NewScanner(strings.NewReader("A quick brown fox"))
+	scanner.Split(bufio.ScanWords)
+	loop := FromScanner(scanner)
+	Printf("%q\n", loop.ToSlice())
+	// Output:
+	// ["A" "quick" "brown" "fox"]
+}
+
+type usingResource struct {
+	*strings.Reader
+}
+
+func (r usingResource) Close() error {
+	Println("closed")
+	return nil
+}
+
+func ExampleUsing() {
+	open := func() (usingResource, error) {
+		Println("opened")
+		return usingResource{strings.NewReader("a\nb\nc\n")}, nil
+	}
+	loop := Using(open, func(r usingResource) Enumerator[string] {
+		return FromReader(r)
+	})
+	Printf("%q\n", loop.Take(2).ToSlice())
+	// Output:
+	// opened
+	// closed
+	// ["a" "b"]
+}
+
+func ExampleFromFileLines() {
+	f, err := os.CreateTemp("", "linq")
+	if err != nil {
+		panic(err)
+	}
+	defer os.Remove(f.Name())
+	Fprint(f, "A quick brown fox\njumps over\nthe lazy dog.\n")
+	f.Close()
+
+	loop := FromFileLines(f.Name())
+	Printf("%q\n", loop.Take(2).ToSlice())
+	Println(loop.Count())
+	// Output:
+	// ["A quick brown fox" "jumps over"]
+	// 3
+}
+
 func ExampleEnumerator_fizzBuzz() {
 	var fizzbuzz Enumerator[any] = Select(func(i int) any {
 		if i%3 == 0 {
@@ -330,3 +652,17 @@ func ExampleEnumerator_fizzBuzz() {
 	// Output:
 	// 1 2 Fizz 4 Buzz Fizz 7 8 Fizz Buzz 11 Fizz 13 14 FizzBuzz 16 17 Fizz 19
 }
+
+func BenchmarkEnumerator_ToList(b *testing.B) {
+	loop := Range(0, 10000)
+	for i := 0; i < b.N; i++ {
+		loop.ToList()
+	}
+}
+
+func BenchmarkEnumerator_ToSlice(b *testing.B) {
+	loop := Range(0, 10000)
+	for i := 0; i < b.N; i++ {
+		loop.ToSlice()
+	}
+}
@@ -0,0 +1,18 @@
+// Package example shows the code which linqgen generates.
+package example
+
+//go:generate go run github.com/nukata/linq-in-go/linq/linqgen -type Pet -select string,int -groupby string -join Owner:string
+
+// Pet is an element type of the example.
+type Pet struct {
+	Name  string
+	Kind  string
+	Age   int
+	Owner string
+}
+
+// Owner is an inner type of the example.
+type Owner struct {
+	Name string
+	City string
+}
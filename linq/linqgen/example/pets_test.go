@@ -0,0 +1,49 @@
+package example
+
+import (
+	"fmt"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+var (
+	pets = linq.From([]Pet{
+		{"Tama", "cat", 5, "Sato"}, {"Pochi", "dog", 2, "Ito"},
+		{"Mike", "cat", 7, "Ito"}, {"Hachi", "dog", 4, "Kato"},
+	})
+	owners = linq.From([]Owner{{"Sato", "Sendai"}, {"Ito", "Osaka"}})
+)
+
+func ExamplePetQuery() {
+	names := NewPetQuery(pets).
+		Where(func(p Pet) bool { return p.Age > 3 }).
+		OrderBy(func(a, b Pet) bool { return a.Name < b.Name }).
+		SelectString(func(p Pet) string { return p.Name })
+	fmt.Println(names.ToSlice())
+	// Output: [Hachi Mike Tama]
+}
+
+func ExamplePetQuery_GroupByString() {
+	kinds := NewPetQuery(pets).GroupByString(func(p Pet) string {
+		return p.Kind
+	})
+	kinds(func(g linq.Grouping[string, Pet]) {
+		fmt.Println(g.Key, len(g.Elements))
+	})
+	// Output:
+	// cat 2
+	// dog 2
+}
+
+func ExamplePetQuery_JoinOwner() {
+	pairs := NewPetQuery(pets).JoinOwner(func(p Pet) string {
+		return p.Owner
+	}, func(o Owner) string { return o.Name }, owners)
+	pairs(func(p linq.Pair[Pet, Owner]) {
+		fmt.Println(p.First.Name, p.Second.City)
+	})
+	// Output:
+	// Tama Sendai
+	// Pochi Osaka
+	// Mike Osaka
+}
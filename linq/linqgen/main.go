@@ -0,0 +1,296 @@
+// Linqgen generates a fluent wrapper of linq.Enumerator for a concrete
+// element type, whose Select, GroupBy and Join methods are specialized to
+// the given types.
+// It works around the restriction of Go that a method cannot have type
+// parameters of its own, e.g. for
+//
+//	//go:generate go run github.com/nukata/linq-in-go/linq/linqgen -type Pet -select string,int -groupby string -join Owner:string
+//
+// it generates pet_linq.go with
+//
+//	type PetQuery struct{ linq.Enumerator[Pet] }
+//	func NewPetQuery(loop linq.Enumerator[Pet]) PetQuery
+//	func (q PetQuery) Where(predicate func(Pet) bool) PetQuery
+//	...
+//	func (q PetQuery) SelectString(f func(Pet) string) linq.Enumerator[string]
+//	func (q PetQuery) SelectInt(f func(Pet) int) linq.Enumerator[int]
+//	func (q PetQuery) GroupByString(key func(Pet) string) linq.Enumerator[linq.Grouping[string, Pet]]
+//	func (q PetQuery) JoinOwner(outerKey func(Pet) string, innerKey func(Owner) string, inner linq.Enumerator[Owner]) linq.Enumerator[linq.Pair[Pet, Owner]]
+//
+// so that
+//
+//	NewPetQuery(pets).Where(isCat).OrderBy(byAge).SelectString(name)
+//
+// reads from left to right.
+//
+// The flags are:
+//
+//	-type T          the element type (required)
+//	-name N          the name of the wrapper (default TQuery)
+//	-select list     the result types of Select methods
+//	-groupby list    the key types of GroupBy methods
+//	-join list       the inner and key types of Join methods as Inner:Key
+//	-import list     the import paths which the types need
+//	-package p       the package name (default $GOPACKAGE)
+//	-output file     the output file (default t_linq.go)
+//
+// Each list is separated by commas.
+// Each item of -select, -groupby and -join may be prefixed with Name= to
+// name its method explicitly, e.g. -select Label=string makes SelectLabel.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// method is a specialized method to generate.
+type method struct {
+	Name  string // the suffix of the method name
+	Type  string // the result type of Select or the key type of GroupBy
+	Inner string // the inner type of Join
+}
+
+// config is the input of generate.
+type config struct {
+	Args    []string // the command line arguments to record
+	Package string
+	Type    string
+	Name    string
+	Imports []string
+	Selects []method
+	GroupBy []method
+	Joins   []method
+}
+
+// exported returns an exported identifier made of the letters and digits
+// of typ, e.g. "String" for "string", "TimeDuration" for "time.Duration"
+// and "SliceByte" for "[]byte".
+func exported(typ string) string {
+	var b strings.Builder
+	upper := true
+	for i, r := range typ {
+		switch {
+		case r == '*':
+			b.WriteString("Ptr")
+			upper = true
+		case strings.HasPrefix(typ[i:], "[]"):
+			b.WriteString("Slice")
+			upper = true
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+				upper = false
+			}
+			b.WriteRune(r)
+		default:
+			upper = true
+		}
+	}
+	return b.String()
+}
+
+// split splits a comma-separated list, ignoring empty items.
+func split(list string) []string {
+	var result []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// methods parses a list of -select or -groupby.
+func methods(list string) []method {
+	var result []method
+	for _, item := range split(list) {
+		m := method{Type: item}
+		if i := strings.Index(item, "="); i >= 0 {
+			m.Name, m.Type = item[:i], item[i+1:]
+		} else {
+			m.Name = exported(item)
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+// joins parses a list of -join.
+func joins(list string) ([]method, error) {
+	var result []method
+	for _, item := range split(list) {
+		var m method
+		if i := strings.Index(item, "="); i >= 0 {
+			m.Name, item = item[:i], item[i+1:]
+		}
+		i := strings.LastIndex(item, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("-join %s: want Inner:Key", item)
+		}
+		m.Inner, m.Type = item[:i], item[i+1:]
+		if m.Name == "" {
+			m.Name = exported(m.Inner)
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+var wrapper = template.Must(template.New("wrapper").Funcs(template.FuncMap{
+	"join": strings.Join,
+}).Parse(`
+// Code generated by "linqgen {{join .Args " "}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"math/rand"
+	{{range .Imports}}
+	"{{.}}"{{end}}
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+{{$T := .Type}}{{$Q := .Name -}}
+// {{$Q}} is a linq.Enumerator[{{$T}}] whose methods can be chained.
+type {{$Q}} struct {
+	linq.Enumerator[{{$T}}]
+}
+
+// New{{$Q}} wraps loop in a {{$Q}}.
+func New{{$Q}}(loop linq.Enumerator[{{$T}}]) {{$Q}} {
+	return {{$Q}}{loop}
+}
+
+// Where is linq.Enumerator.Where which returns a {{$Q}}.
+func (q {{$Q}}) Where(predicate func({{$T}}) bool) {{$Q}} {
+	return {{$Q}}{q.Enumerator.Where(predicate)}
+}
+
+// Tap is linq.Enumerator.Tap which returns a {{$Q}}.
+func (q {{$Q}}) Tap(action func({{$T}})) {{$Q}} {
+	return {{$Q}}{q.Enumerator.Tap(action)}
+}
+
+// Take is linq.Enumerator.Take which returns a {{$Q}}.
+func (q {{$Q}}) Take(n int) {{$Q}} {
+	return {{$Q}}{q.Enumerator.Take(n)}
+}
+
+// TakeWhile is linq.Enumerator.TakeWhile which returns a {{$Q}}.
+func (q {{$Q}}) TakeWhile(predicate func({{$T}}) bool) {{$Q}} {
+	return {{$Q}}{q.Enumerator.TakeWhile(predicate)}
+}
+
+// TakeLast is linq.Enumerator.TakeLast which returns a {{$Q}}.
+func (q {{$Q}}) TakeLast(n int) {{$Q}} {
+	return {{$Q}}{q.Enumerator.TakeLast(n)}
+}
+
+// Skip is linq.Enumerator.Skip which returns a {{$Q}}.
+func (q {{$Q}}) Skip(n int) {{$Q}} {
+	return {{$Q}}{q.Enumerator.Skip(n)}
+}
+
+// SkipWhile is linq.Enumerator.SkipWhile which returns a {{$Q}}.
+func (q {{$Q}}) SkipWhile(predicate func({{$T}}) bool) {{$Q}} {
+	return {{$Q}}{q.Enumerator.SkipWhile(predicate)}
+}
+
+// Concat is linq.Enumerator.Concat which returns a {{$Q}}.
+func (q {{$Q}}) Concat(loop2 linq.Enumerator[{{$T}}]) {{$Q}} {
+	return {{$Q}}{q.Enumerator.Concat(loop2)}
+}
+
+// OrderBy is linq.Enumerator.OrderBy which returns a {{$Q}}.
+func (q {{$Q}}) OrderBy(less func(a, b {{$T}}) bool) {{$Q}} {
+	return {{$Q}}{q.Enumerator.OrderBy(less)}
+}
+
+// Reverse is linq.Enumerator.Reverse which returns a {{$Q}}.
+func (q {{$Q}}) Reverse() {{$Q}} {
+	return {{$Q}}{q.Enumerator.Reverse()}
+}
+
+// Shuffle is linq.Enumerator.Shuffle which returns a {{$Q}}.
+func (q {{$Q}}) Shuffle(r *rand.Rand) {{$Q}} {
+	return {{$Q}}{q.Enumerator.Shuffle(r)}
+}
+{{range .Selects}}
+// Select{{.Name}} is linq.Select to {{.Type}}.
+func (q {{$Q}}) Select{{.Name}}(f func({{$T}}) {{.Type}}) linq.Enumerator[{{.Type}}] {
+	return linq.Select(f, q.Enumerator)
+}
+{{end}}{{range .GroupBy}}
+// GroupBy{{.Name}} is linq.GroupBy with keys of {{.Type}}.
+func (q {{$Q}}) GroupBy{{.Name}}(key func({{$T}}) {{.Type}}) linq.Enumerator[linq.Grouping[{{.Type}}, {{$T}}]] {
+	return linq.GroupBy(key, q.Enumerator)
+}
+{{end}}{{range .Joins}}
+// Join{{.Name}} is linq.Join with inner of {{.Inner}} and keys of {{.Type}},
+// which pairs the matching elements.
+func (q {{$Q}}) Join{{.Name}}(outerKey func({{$T}}) {{.Type}}, innerKey func({{.Inner}}) {{.Type}}, inner linq.Enumerator[{{.Inner}}]) linq.Enumerator[linq.Pair[{{$T}}, {{.Inner}}]] {
+	return linq.Join(outerKey, innerKey, linq.MakePair[{{$T}}, {{.Inner}}], q.Enumerator, inner)
+}
+{{end}}`[1:]))
+
+// generate generates the source of the wrapper for c.
+func generate(c config) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := wrapper.Execute(&buf, c); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("linqgen: ")
+	typ := flag.String("type", "", "the element type (required)")
+	name := flag.String("name", "", "the name of the wrapper")
+	selects := flag.String("select", "", "the result types of Select")
+	groupBy := flag.String("groupby", "", "the key types of GroupBy")
+	join := flag.String("join", "", "the inner and key types of Join")
+	imports := flag.String("import", "", "the import paths")
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "the package name")
+	output := flag.String("output", "", "the output file")
+	flag.Parse()
+	if *typ == "" || *pkg == "" || flag.NArg() != 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	c := config{
+		Args:    os.Args[1:],
+		Package: *pkg,
+		Type:    *typ,
+		Name:    *name,
+		Imports: split(*imports),
+		Selects: methods(*selects),
+		GroupBy: methods(*groupBy),
+	}
+	if c.Name == "" {
+		c.Name = exported(*typ) + "Query"
+	}
+	var err error
+	if c.Joins, err = joins(*join); err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(c)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *output == "" {
+		*output = strings.ToLower(exported(*typ)) + "_linq.go"
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
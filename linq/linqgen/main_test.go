@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+)
+
+func Example_exported() {
+	for _, typ := range []string{"string", "time.Duration", "*Pet",
+		"[]byte", "map[string]int"} {
+		fmt.Println(exported(typ))
+	}
+	// Output:
+	// String
+	// TimeDuration
+	// PtrPet
+	// SliceByte
+	// MapStringInt
+}
+
+// Example_upToDate checks that example/pet_linq.go is what linqgen
+// generates now.
+func Example_upToDate() {
+	args := []string{"-type", "Pet", "-select", "string,int",
+		"-groupby", "string", "-join", "Owner:string"}
+	js, err := joins("Owner:string")
+	if err != nil {
+		fmt.Println(err)
+	}
+	src, err := generate(config{
+		Args:    args,
+		Package: "example",
+		Type:    "Pet",
+		Name:    "PetQuery",
+		Selects: methods("string,int"),
+		GroupBy: methods("string"),
+		Joins:   js,
+	})
+	if err != nil {
+		fmt.Println(err)
+	}
+	committed, err := os.ReadFile("example/pet_linq.go")
+	fmt.Println(bytes.Equal(src, committed), err)
+	// Output: true <nil>
+}
+
+func Example_joins() {
+	js, err := joins("Owner:string,ByCity=Owner:City")
+	fmt.Println(js, err)
+	_, err = joins("Owner")
+	fmt.Println(err)
+	// Output:
+	// [{Owner string Owner} {ByCity City Owner}] <nil>
+	// -join Owner: want Inner:Key
+}
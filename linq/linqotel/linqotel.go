@@ -0,0 +1,117 @@
+// Package linqotel traces the enumerations of linq.Enumerator with
+// OpenTelemetry.
+//
+// Each enumeration of a traced Enumerator creates a span which has the
+// number of elements and the duration as its attributes.
+// The stages of a Pipeline create child spans of the span of the
+// enumeration in which they are enumerated, i.e. downstream.
+package linqotel
+
+import (
+	"context"
+	"time"
+
+	"github.com/nukata/linq-in-go/linq"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The keys of the attributes of spans
+const (
+	ElementsKey = attribute.Key("linq.elements")    // int64
+	DurationKey = attribute.Key("linq.duration_ms") // float64
+)
+
+// Trace creates an Enumerator which starts a span named name as a child of
+// the span in ctx with tracer each time it is enumerated, and ends the
+// span when the enumeration of loop completes or terminates early.
+// If loop panics with an error, the error is recorded in the span.
+func Trace[T any](ctx context.Context, tracer trace.Tracer, name string,
+	loop linq.Enumerator[T]) linq.Enumerator[T] {
+	return func(yield func(T)) {
+		_, span := tracer.Start(ctx, name)
+		enumerate(span, loop, yield)
+	}
+}
+
+// enumerate applies yield to each element of loop, recording them in span,
+// and ends span.
+func enumerate[T any](span trace.Span, loop linq.Enumerator[T],
+	yield func(T)) {
+	var n int64
+	inYield := false
+	start := time.Now()
+	defer func() {
+		if !inYield {
+			if r := recover(); r != nil {
+				if err, ok := r.(error); ok {
+					span.RecordError(err)
+					span.SetStatus(codes.Error, err.Error())
+				}
+				defer panic(r)
+			}
+		}
+		elapsed := time.Since(start)
+		span.SetAttributes(ElementsKey.Int64(n),
+			DurationKey.Float64(float64(elapsed)/float64(time.Millisecond)))
+		span.End()
+	}()
+	loop(func(element T) {
+		n++
+		inYield = true
+		yield(element)
+		inYield = false
+	})
+}
+
+// Pipeline traces the stages of a pipeline as a tree of spans.
+// A Pipeline must not be enumerated in several goroutines at once.
+type Pipeline struct {
+	tracer trace.Tracer
+	ctx    context.Context // the context of the innermost span
+}
+
+// NewPipeline creates a Pipeline which starts spans with tracer.
+func NewPipeline(tracer trace.Tracer) *Pipeline {
+	return &Pipeline{tracer: tracer, ctx: context.Background()}
+}
+
+// Run creates an Enumerator which starts a span named name as a child of
+// the span in ctx each time it is enumerated.
+// The stages of p enumerated within loop create descendant spans of it.
+func Run[T any](ctx context.Context, p *Pipeline, name string,
+	loop linq.Enumerator[T]) linq.Enumerator[T] {
+	return func(yield func(T)) {
+		enumerateStage(p, ctx, name, loop, yield)
+	}
+}
+
+// Stage creates an Enumerator which starts a span named name each time it
+// is enumerated.
+// The span is a child of the span of the stage or Run of p which is
+// enumerating it.
+func Stage[T any](p *Pipeline, name string,
+	loop linq.Enumerator[T]) linq.Enumerator[T] {
+	return func(yield func(T)) {
+		enumerateStage(p, p.ctx, name, loop, yield)
+	}
+}
+
+// enumerateStage starts a span as a child of the span in parent and makes
+// it the innermost span of p while it enumerates loop.
+func enumerateStage[T any](p *Pipeline, parent context.Context, name string,
+	loop linq.Enumerator[T], yield func(T)) {
+	saved := p.ctx
+	ctx, span := p.tracer.Start(parent, name)
+	p.ctx = ctx
+	defer func() {
+		p.ctx = saved
+	}()
+	enumerate(span, loop, func(element T) {
+		// Downstream runs in the context of the enumerating stage.
+		p.ctx = saved
+		yield(element)
+		p.ctx = ctx
+	})
+}
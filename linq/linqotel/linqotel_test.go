@@ -0,0 +1,80 @@
+package linqotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nukata/linq-in-go/linq"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// newRecorder returns a tracer provider which records spans in memory.
+func newRecorder() (*sdktrace.TracerProvider, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(recorder))
+	return provider, recorder
+}
+
+// printSpans prints the name, the parent and the elements of each span.
+func printSpans(recorder *tracetest.SpanRecorder) {
+	names := map[string]string{}
+	for _, s := range recorder.Ended() {
+		names[s.SpanContext().SpanID().String()] = s.Name()
+	}
+	for _, s := range recorder.Ended() {
+		parent := names[s.Parent().SpanID().String()]
+		elements := int64(-1)
+		for _, a := range s.Attributes() {
+			if a.Key == ElementsKey {
+				elements = a.Value.AsInt64()
+			}
+		}
+		fmt.Printf("%s <- %q: %d %v\n", s.Name(), parent, elements,
+			s.Status().Code)
+	}
+}
+
+func ExampleTrace() {
+	provider, recorder := newRecorder()
+	tracer := provider.Tracer("example")
+
+	loop := Trace(context.Background(), tracer, "squares",
+		linq.Select(func(i int) int { return i * i }, linq.IntsFrom(1)))
+	fmt.Println(loop.Take(3).ToSlice())
+
+	var failing linq.Enumerator[int] = func(yield func(int)) {
+		yield(1)
+		panic(errors.New("poi"))
+	}
+	failing = Trace(context.Background(), tracer, "failing", failing)
+	fmt.Println(failing.Catch(func(err error) linq.Enumerator[int] {
+		return linq.Empty[int]()
+	}).ToSlice())
+	printSpans(recorder)
+	// Output:
+	// [1 4 9]
+	// [1]
+	// squares <- "": 3 Unset
+	// failing <- "": 1 Error
+}
+
+func ExamplePipeline() {
+	provider, recorder := newRecorder()
+	p := NewPipeline(provider.Tracer("example"))
+
+	source := Stage(p, "source", linq.Range(1, 10))
+	evens := Stage(p, "where", source.Where(func(i int) bool {
+		return i%2 == 0
+	}))
+	loop := Run(context.Background(), p, "pipeline", evens)
+	fmt.Println(loop.ToSlice())
+	printSpans(recorder)
+	// Output:
+	// [2 4 6 8 10]
+	// source <- "where": 10 Unset
+	// where <- "pipeline": 5 Unset
+	// pipeline <- "": 5 Unset
+}
@@ -0,0 +1,324 @@
+// Package linqscript runs queries written in a minimal SQL-like language
+// over linq.Enumerator[map[string]any], e.g.
+//
+//	select Name, Age from pets where Age > 3 order by Name limit 10
+//
+// so that scripting and REPL users can use the operators without writing
+// Go.
+//
+// The clauses are, in this order:
+//
+//	select *  or  select field, ...
+//	from source
+//	where expression       (optional; see dynamic.Expr for the syntax)
+//	order by field [asc | desc], ...       (optional)
+//	limit n                (optional)
+//
+// The keywords are case-insensitive.
+// The fields may be dotted paths as with dynamic.Field.
+package linqscript
+
+import (
+	"errors"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/nukata/linq-in-go/linq"
+	"github.com/nukata/linq-in-go/linq/dynamic"
+)
+
+// Row is an element of the sources and the results of queries.
+type Row = map[string]any
+
+// ErrUnknownSource is the error for a query from a source which is not
+// given to Run.
+var ErrUnknownSource = errors.New("linqscript: unknown source")
+
+// Order is a key of the order by clause.
+type Order struct {
+	Field string
+	Desc  bool
+}
+
+// Query is a parsed query.
+type Query struct {
+	Fields  []string // nil for select *
+	Source  string
+	Where   *dynamic.Expr // nil if absent
+	OrderBy []Order
+	Limit   int // -1 if absent
+}
+
+// lexeme is a token with its offset in the query.
+type lexeme struct {
+	offset int
+	tok    token.Token
+	lit    string
+}
+
+// keyword reports whether l is the keyword k.
+// Note that select is also a keyword of Go.
+func (l lexeme) keyword(k string) bool {
+	return (l.tok == token.IDENT || l.tok.IsKeyword()) &&
+		strings.EqualFold(l.lit, k)
+}
+
+// Parse parses query into a Query.
+func Parse(query string) (*Query, error) {
+	var err error
+	var lexemes []lexeme
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(query))
+	s.Init(file, []byte(query), func(pos token.Position, msg string) {
+		if err == nil {
+			err = fmt.Errorf("linqscript: %d: %s", pos.Column, msg)
+		}
+	}, 0)
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+		if lit == "" {
+			lit = tok.String()
+		}
+		lexemes = append(lexemes, lexeme{file.Offset(pos), tok, lit})
+		if tok == token.EOF {
+			break
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{query: query, lexemes: lexemes}
+	return p.parse()
+}
+
+// parser parses the lexemes of a query.
+type parser struct {
+	query   string
+	lexemes []lexeme
+	i       int
+}
+
+func (p *parser) peek() lexeme { return p.lexemes[p.i] }
+
+func (p *parser) next() lexeme {
+	l := p.lexemes[p.i]
+	if l.tok != token.EOF {
+		p.i++
+	}
+	return l
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("linqscript: %d: %s", p.peek().offset+1,
+		fmt.Sprintf(format, args...))
+}
+
+// expect consumes the keyword k.
+func (p *parser) expect(k string) error {
+	if !p.peek().keyword(k) {
+		return p.errorf("expected %s", k)
+	}
+	p.next()
+	return nil
+}
+
+// isName reports whether l can be a field name.
+// The keywords of Go are allowed, since the keys of JSON such as type and
+// range often are.
+func (l lexeme) isName() bool {
+	return l.tok == token.IDENT || l.tok.IsKeyword()
+}
+
+// field parses a dotted field name.
+func (p *parser) field() (string, error) {
+	if !p.peek().isName() {
+		return "", p.errorf("expected a field name")
+	}
+	name := p.next().lit
+	for p.peek().tok == token.PERIOD {
+		p.next()
+		if !p.peek().isName() {
+			return "", p.errorf("expected a field name")
+		}
+		name += "." + p.next().lit
+	}
+	return name, nil
+}
+
+func (p *parser) parse() (*Query, error) {
+	q := &Query{Limit: -1}
+	if err := p.expect("select"); err != nil {
+		return nil, err
+	}
+	if p.peek().tok == token.MUL {
+		p.next()
+	} else {
+		for {
+			f, err := p.field()
+			if err != nil {
+				return nil, err
+			}
+			q.Fields = append(q.Fields, f)
+			if p.peek().tok != token.COMMA {
+				break
+			}
+			p.next()
+		}
+	}
+	if err := p.expect("from"); err != nil {
+		return nil, err
+	}
+	if p.peek().tok != token.IDENT {
+		return nil, p.errorf("expected a source name")
+	}
+	q.Source = p.next().lit
+	if p.peek().keyword("where") {
+		p.next()
+		// The expression extends to the next clause at depth 0.
+		start := p.peek().offset
+		depth := 0
+		for l := p.peek(); l.tok != token.EOF; l = p.peek() {
+			if depth == 0 && (l.keyword("order") || l.keyword("limit")) {
+				break
+			}
+			switch l.tok {
+			case token.LPAREN:
+				depth++
+			case token.RPAREN:
+				depth--
+			}
+			p.next()
+		}
+		source := strings.TrimSpace(p.query[start:p.peek().offset])
+		e, err := dynamic.Compile(source)
+		if err != nil {
+			return nil, fmt.Errorf("linqscript: where: %w", err)
+		}
+		q.Where = e
+	}
+	if p.peek().keyword("order") {
+		p.next()
+		if err := p.expect("by"); err != nil {
+			return nil, err
+		}
+		for {
+			f, err := p.field()
+			if err != nil {
+				return nil, err
+			}
+			o := Order{Field: f}
+			if p.peek().keyword("desc") {
+				p.next()
+				o.Desc = true
+			} else if p.peek().keyword("asc") {
+				p.next()
+			}
+			q.OrderBy = append(q.OrderBy, o)
+			if p.peek().tok != token.COMMA {
+				break
+			}
+			p.next()
+		}
+	}
+	if p.peek().keyword("limit") {
+		p.next()
+		n, err := strconv.Atoi(p.peek().lit)
+		if p.peek().tok != token.INT || err != nil {
+			return nil, p.errorf("expected a limit")
+		}
+		p.next()
+		q.Limit = n
+	}
+	if p.peek().tok != token.EOF {
+		return nil, p.errorf("unexpected %s", p.peek().lit)
+	}
+	return q, nil
+}
+
+// less compares two rows by the keys of the order by clause.
+// The rows whose values are not comparable, e.g. missing, are ordered
+// after the others.
+func (q *Query) less(a, b Row) bool {
+	for _, o := range q.OrderBy {
+		x, _ := dynamic.Field(a, o.Field)
+		y, _ := dynamic.Field(b, o.Field)
+		c, ok := dynamic.Compare(x, y)
+		if !ok {
+			_, okX := dynamic.Compare(x, x)
+			_, okY := dynamic.Compare(y, y)
+			if okX != okY {
+				return okX
+			}
+			continue
+		}
+		if o.Desc {
+			c = -c
+		}
+		if c != 0 {
+			return c < 0
+		}
+	}
+	return false
+}
+
+// Pipeline builds the pipeline of q over sources.
+// The where clause is applied first, then order by, limit and select.
+// It returns an error wrapping ErrUnknownSource if q.Source is not in
+// sources.
+// The pipeline panics with an error wrapping dynamic.ErrNotBool if the
+// where clause does not result in a bool for a row.
+func (q *Query) Pipeline(
+	sources map[string]linq.Enumerator[Row]) (linq.Enumerator[Row], error) {
+	loop, ok := sources[q.Source]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSource, q.Source)
+	}
+	if q.Where != nil {
+		where := q.Where
+		loop = loop.Where(func(row Row) bool {
+			v, err := where.Eval(row)
+			if err != nil {
+				panic(err)
+			}
+			if b, ok := v.(bool); ok {
+				return b
+			}
+			panic(fmt.Errorf("%w: %s", dynamic.ErrNotBool, where))
+		})
+	}
+	if q.OrderBy != nil {
+		loop = loop.OrderBy(q.less)
+	}
+	if q.Limit >= 0 {
+		loop = loop.Take(q.Limit)
+	}
+	if q.Fields != nil {
+		loop = linq.Select(func(row Row) Row {
+			result := make(Row, len(q.Fields))
+			for _, f := range q.Fields {
+				if v, ok := dynamic.Field(row, f); ok {
+					result[f] = v
+				}
+			}
+			return result
+		}, loop)
+	}
+	return loop, nil
+}
+
+// Run parses query and builds its pipeline over sources.
+func Run(query string,
+	sources map[string]linq.Enumerator[Row]) (linq.Enumerator[Row], error) {
+	q, err := Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	return q.Pipeline(sources)
+}
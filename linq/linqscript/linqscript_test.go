@@ -0,0 +1,98 @@
+package linqscript_test
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nukata/linq-in-go/linq"
+	"github.com/nukata/linq-in-go/linq/linqscript"
+)
+
+var sources = map[string]linq.Enumerator[linqscript.Row]{
+	"pets": linq.From([]linqscript.Row{
+		{"Name": "Tama", "Age": 5, "Kind": "cat"},
+		{"Name": "Pochi", "Age": 2, "Kind": "dog"},
+		{"Name": "Mike", "Age": 7, "Kind": "cat"},
+		{"Name": "Hachi", "Age": 4, "Kind": "dog"},
+	}),
+}
+
+func ExampleRun() {
+	loop, err := linqscript.Run(
+		"select Name from pets where Age > 3 order by Name limit 2", sources)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	loop(func(row linqscript.Row) {
+		fmt.Println(row)
+	})
+	// Output:
+	// map[Name:Hachi]
+	// map[Name:Mike]
+}
+
+func ExampleRun_orderBy() {
+	loop, _ := linqscript.Run(
+		"SELECT Name, Age FROM pets ORDER BY Kind DESC, Age", sources)
+	loop(func(row linqscript.Row) {
+		fmt.Println(row["Name"], row["Age"])
+	})
+	// Output:
+	// Pochi 2
+	// Hachi 4
+	// Tama 5
+	// Mike 7
+}
+
+func ExampleRun_where() {
+	loop, _ := linqscript.Run(
+		`select * from pets where (Kind == "cat" || Age < 3) && Age != 7`,
+		sources)
+	fmt.Println(loop.Count())
+	// Output: 2
+}
+
+func ExampleRun_keyword() {
+	// The fields may be named after the keywords of Go, as in JSON.
+	events := map[string]linq.Enumerator[linqscript.Row]{
+		"events": linq.From([]linqscript.Row{
+			{"type": "open", "range": 3},
+			{"type": "close", "range": 5},
+			{"type": "read", "range": 1},
+		}),
+	}
+	loop, err := linqscript.Run(
+		"select type from events where range > 2 order by type desc", events)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	loop(func(row linqscript.Row) {
+		fmt.Println(row)
+	})
+	// Output:
+	// map[type:open]
+	// map[type:close]
+}
+
+func ExampleParse() {
+	q, err := linqscript.Parse("select Owner.Name from pets where Age >= 3" +
+		" order by Age desc limit 10")
+	fmt.Println(q.Fields, q.Source, q.Where, q.OrderBy, q.Limit, err)
+	_, err = linqscript.Parse("select Name pets")
+	fmt.Println(err)
+	_, err = linqscript.Parse("select Name from pets limit ten")
+	fmt.Println(err)
+	// Output:
+	// [Owner.Name] pets Age >= 3 [{Age true}] 10 <nil>
+	// linqscript: 13: expected from
+	// linqscript: 29: expected a limit
+}
+
+func ExampleQuery_Pipeline() {
+	q, _ := linqscript.Parse("select Name from people")
+	_, err := q.Pipeline(sources)
+	fmt.Println(err, errors.Is(err, linqscript.ErrUnknownSource))
+	// Output: linqscript: unknown source: people true
+}
@@ -0,0 +1,77 @@
+package linqtest
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+// update is the flag to rewrite golden files:
+//
+//	go test -linqtest.update
+//
+// It is prefixed to avoid conflicts with the -update flags which tests may
+// define by themselves.
+var update = flag.Bool("linqtest.update", false,
+	"rewrite the golden files of linqtest.ToGolden")
+
+// ToGolden formats each element of loop as a line by format and compares
+// the lines with the golden file at path, e.g. testdata/name.golden.
+// With the -linqtest.update flag, it writes the lines to the file instead.
+// It reports the differing lines to t.
+// format should not return strings which contain newlines.
+func ToGolden[T any](t testing.TB, path string, format func(T) string,
+	loop linq.Enumerator[T]) bool {
+	t.Helper()
+	var b strings.Builder
+	loop(func(element T) {
+		b.WriteString(format(element))
+		b.WriteByte('\n')
+	})
+	got := b.String()
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("cannot update the golden file: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("cannot update the golden file: %v", err)
+		}
+		return true
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("cannot read the golden file (run with -linqtest.update "+
+			"to create it): %v", err)
+		return false
+	}
+	if got == string(want) {
+		return true
+	}
+	wantLines := lines(string(want))
+	gotLines := lines(got)
+	var d diffs
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		switch {
+		case i >= len(gotLines):
+			d.add("line %d: want %q, got none", i+1, wantLines[i])
+		case i >= len(wantLines):
+			d.add("line %d: want none, got %q", i+1, gotLines[i])
+		case wantLines[i] != gotLines[i]:
+			d.add("line %d: want %q, got %q", i+1, wantLines[i], gotLines[i])
+		}
+	}
+	t.Errorf("output differs from %s:\n\t%v", path, &d)
+	return false
+}
+
+// lines splits s into lines without trailing newlines.
+func lines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
@@ -0,0 +1,51 @@
+package linqtest
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+// dirRecorder is a recorder which prints dir as $DIR.
+type dirRecorder struct {
+	recorder
+	dir string
+}
+
+func (r dirRecorder) Errorf(format string, args ...any) {
+	s := fmt.Sprintf(format, args...)
+	fmt.Println(strings.ReplaceAll(s, r.dir, "$DIR"))
+}
+
+func ExampleToGolden() {
+	dir, _ := os.MkdirTemp("", "linqtest")
+	t := dirRecorder{dir: dir}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "testdata", "squares.golden")
+	square := func(i int) string { return fmt.Sprint(i, "^2 = ", i*i) }
+
+	// Without the golden file
+	fmt.Println(ToGolden(t, path, square, linq.Range(1, 3)))
+
+	// go test -linqtest.update
+	flag.Set("linqtest.update", "true")
+	fmt.Println(ToGolden(t, path, square, linq.Range(1, 3)))
+	flag.Set("linqtest.update", "false")
+
+	fmt.Println(ToGolden(t, path, square, linq.Range(1, 3)))
+	fmt.Println(ToGolden(t, path, square, linq.Range(2, 3)))
+	// Output:
+	// cannot read the golden file (run with -linqtest.update to create it): open $DIR/testdata/squares.golden: no such file or directory
+	// false
+	// true
+	// true
+	// output differs from $DIR/testdata/squares.golden:
+	// 	line 1: want "1^2 = 1", got "2^2 = 4"
+	// 	line 2: want "2^2 = 4", got "3^2 = 9"
+	// 	line 3: want "3^2 = 9", got "4^2 = 16"
+	// false
+}
@@ -0,0 +1,135 @@
+// Package linqtest provides helpers to test code which uses
+// linq.Enumerator.
+//
+// The assertions enumerate the sequences under test and report the
+// differences from the expectation through testing.TB.
+package linqtest
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+// maxReported is the maximum number of differences which an assertion
+// reports.
+const maxReported = 10
+
+// diffs collects differences up to maxReported.
+type diffs struct {
+	lines []string
+	more  int
+}
+
+func (d *diffs) add(format string, args ...any) {
+	if len(d.lines) < maxReported {
+		d.lines = append(d.lines, fmt.Sprintf(format, args...))
+	} else {
+		d.more++
+	}
+}
+
+func (d *diffs) String() string {
+	s := strings.Join(d.lines, "\n\t")
+	if d.more > 0 {
+		s += fmt.Sprintf("\n\t... and %d more", d.more)
+	}
+	return s
+}
+
+// AssertEqual checks that got yields the elements of want in order, as
+// compared by reflect.DeepEqual.
+// It reports the length difference and each differing index.
+func AssertEqual[T any](t testing.TB, want []T, got linq.Enumerator[T]) bool {
+	t.Helper()
+	g := got.ToSlice()
+	var d diffs
+	if len(g) != len(want) {
+		d.add("length: want %d, got %d", len(want), len(g))
+	}
+	for i := 0; i < len(g) || i < len(want); i++ {
+		switch {
+		case i >= len(g):
+			d.add("[%d]: want %v, got none", i, want[i])
+		case i >= len(want):
+			d.add("[%d]: want none, got %v", i, g[i])
+		case !reflect.DeepEqual(want[i], g[i]):
+			d.add("[%d]: want %v, got %v", i, want[i], g[i])
+		}
+	}
+	if d.lines != nil {
+		t.Errorf("sequences differ:\n\t%v", &d)
+		return false
+	}
+	return true
+}
+
+// AssertEqualUnordered checks that got yields the elements of want in any
+// order, as compared by reflect.DeepEqual.
+// Duplicate elements must appear as many times as in want.
+// It reports the missing and unexpected elements.
+func AssertEqualUnordered[T any](t testing.TB, want []T,
+	got linq.Enumerator[T]) bool {
+	t.Helper()
+	g := got.ToSlice()
+	matched := make([]bool, len(g))
+	var d diffs
+	for _, w := range want {
+		found := false
+		for j, x := range g {
+			if !matched[j] && reflect.DeepEqual(w, x) {
+				matched[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			d.add("missing: %v", w)
+		}
+	}
+	for j, x := range g {
+		if !matched[j] {
+			d.add("unexpected: %v", x)
+		}
+	}
+	if d.lines != nil {
+		t.Errorf("sequences differ regardless of order:\n\t%v", &d)
+		return false
+	}
+	return true
+}
+
+// AssertEnumeratesWithin checks that loop completes its enumeration
+// within d, e.g. to detect a pipeline which blocks forever.
+// If loop panics, the value is reported.
+// A loop which does not complete is left running in another goroutine.
+func AssertEnumeratesWithin[T any](t testing.TB, d time.Duration,
+	loop linq.Enumerator[T]) bool {
+	t.Helper()
+	done := make(chan any, 1)
+	n := 0
+	go func() {
+		defer func() {
+			done <- recover()
+		}()
+		loop(func(T) {
+			n++
+		})
+	}()
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case r := <-done:
+		if r != nil {
+			t.Errorf("enumeration panicked after %d elements: %v", n, r)
+			return false
+		}
+		return true
+	case <-timer.C:
+		t.Errorf("enumeration did not complete within %v", d)
+		return false
+	}
+}
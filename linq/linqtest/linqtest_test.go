@@ -0,0 +1,60 @@
+package linqtest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+// recorder is a testing.TB which prints the errors.
+type recorder struct {
+	testing.TB
+}
+
+func (recorder) Helper() {}
+
+func (recorder) Errorf(format string, args ...any) {
+	fmt.Printf(format+"\n", args...)
+}
+
+func ExampleAssertEqual() {
+	var t recorder
+	squares := linq.Select(func(i int) int { return i * i }, linq.Range(1, 4))
+	fmt.Println(AssertEqual(t, []int{1, 4, 9, 16}, squares))
+	fmt.Println(AssertEqual(t, []int{1, 4, 8}, squares))
+	// Output:
+	// true
+	// sequences differ:
+	// 	length: want 3, got 4
+	// 	[2]: want 8, got 9
+	// 	[3]: want none, got 16
+	// false
+}
+
+func ExampleAssertEqualUnordered() {
+	var t recorder
+	x := linq.From([]string{"b", "a", "c", "a"})
+	fmt.Println(AssertEqualUnordered(t, []string{"a", "a", "b", "c"}, x))
+	fmt.Println(AssertEqualUnordered(t, []string{"a", "b", "d"}, x))
+	// Output:
+	// true
+	// sequences differ regardless of order:
+	// 	missing: d
+	// 	unexpected: c
+	// 	unexpected: a
+	// false
+}
+
+func ExampleAssertEnumeratesWithin() {
+	var t recorder
+	fmt.Println(AssertEnumeratesWithin(t, time.Second, linq.Range(1, 10)))
+
+	blocked := linq.FromChan((<-chan int)(make(chan int)))
+	fmt.Println(AssertEnumeratesWithin(t, 10*time.Millisecond, blocked))
+	// Output:
+	// true
+	// enumeration did not complete within 10ms
+	// false
+}
@@ -0,0 +1,82 @@
+package linqtest
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+// Mock is a scripted source to test code which consumes Enumerator.
+// It yields fixed elements, optionally with delays or a panic, and counts
+// how it is enumerated, e.g. to check that a consumer stops early.
+// The counters are safe for concurrent enumerations.
+type Mock[T any] struct {
+	elements   []T
+	delay      time.Duration
+	panicAt    int // -1 if no panic
+	panicValue any
+
+	enumerations int64
+	pulled       int64
+	completed    int64
+}
+
+// NewMock creates a Mock which yields elements.
+func NewMock[T any](elements ...T) *Mock[T] {
+	return &Mock[T]{elements: elements, panicAt: -1}
+}
+
+// WithDelay makes m sleep for d before yielding each element.
+// It returns m.
+func (m *Mock[T]) WithDelay(d time.Duration) *Mock[T] {
+	m.delay = d
+	return m
+}
+
+// PanicAt makes m panic with value instead of yielding the element at
+// index.
+// If index is equal to the number of the elements, m panics after
+// yielding all of them.
+// It returns m.
+func (m *Mock[T]) PanicAt(index int, value any) *Mock[T] {
+	m.panicAt, m.panicValue = index, value
+	return m
+}
+
+// Enumerator returns the Enumerator which m scripts.
+func (m *Mock[T]) Enumerator() linq.Enumerator[T] {
+	return func(yield func(T)) {
+		atomic.AddInt64(&m.enumerations, 1)
+		for i, element := range m.elements {
+			if m.delay > 0 {
+				time.Sleep(m.delay)
+			}
+			if i == m.panicAt {
+				panic(m.panicValue)
+			}
+			atomic.AddInt64(&m.pulled, 1)
+			yield(element)
+		}
+		if m.panicAt == len(m.elements) {
+			panic(m.panicValue)
+		}
+		atomic.AddInt64(&m.completed, 1)
+	}
+}
+
+// Enumerations returns how many times m has been enumerated.
+func (m *Mock[T]) Enumerations() int {
+	return int(atomic.LoadInt64(&m.enumerations))
+}
+
+// Pulled returns how many elements m has yielded in all the enumerations.
+func (m *Mock[T]) Pulled() int {
+	return int(atomic.LoadInt64(&m.pulled))
+}
+
+// Completed returns how many enumerations of m have yielded all the
+// elements, i.e. have not been stopped early nor panicked.
+func (m *Mock[T]) Completed() int {
+	return int(atomic.LoadInt64(&m.completed))
+}
@@ -0,0 +1,33 @@
+package linqtest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+func ExampleMock() {
+	m := NewMock(1, 2, 3, 4, 5)
+	first := m.Enumerator().Take(2).ToSlice()
+	fmt.Println(first, m.Enumerations(), m.Pulled(), m.Completed())
+
+	all := m.Enumerator().ToSlice()
+	fmt.Println(all, m.Enumerations(), m.Pulled(), m.Completed())
+	// Output:
+	// [1 2] 1 2 0
+	// [1 2 3 4 5] 2 7 1
+}
+
+func ExampleMock_PanicAt() {
+	m := NewMock("a", "b", "c").PanicAt(2, errors.New("broken"))
+	for _, n := range linq.Materialize(m.Enumerator()).ToSlice() {
+		fmt.Println(n)
+	}
+	fmt.Println(m.Pulled(), m.Completed())
+	// Output:
+	// OnNext(a)
+	// OnNext(b)
+	// OnError(broken)
+	// 2 0
+}
@@ -0,0 +1,130 @@
+package linqtest
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+// ArbitraryInts creates an Enumerator of a random length from 0 to maxLen,
+// whose elements are random ints in [-100, 100).
+// The elements are fixed when it is created, so that every enumeration
+// yields the same ones.
+func ArbitraryInts(r *rand.Rand, maxLen int) linq.Enumerator[int] {
+	return ArbitraryOf(r, maxLen, func(r *rand.Rand) int {
+		return r.Intn(200) - 100
+	})
+}
+
+// ArbitraryOf creates an Enumerator of a random length from 0 to maxLen,
+// whose elements are made by gen.
+// The elements are fixed when it is created, so that every enumeration
+// yields the same ones.
+func ArbitraryOf[T any](r *rand.Rand, maxLen int,
+	gen func(*rand.Rand) T) linq.Enumerator[T] {
+	x := make([]T, r.Intn(maxLen+1))
+	for i := range x {
+		x[i] = gen(r)
+	}
+	return linq.From(x)
+}
+
+// ForAll checks that prop holds for n values made by gen, and reports the
+// first counterexample to t.
+func ForAll[T any](t testing.TB, r *rand.Rand, n int,
+	gen func(*rand.Rand) T, prop func(T) bool) bool {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		x := gen(r)
+		if !prop(x) {
+			t.Errorf("property does not hold for %v", show(x))
+			return false
+		}
+	}
+	return true
+}
+
+// show formats x, enumerating it if it is an Enumerator.
+func show(x any) any {
+	v := reflect.ValueOf(x)
+	if v.Kind() == reflect.Func && v.Type().NumIn() == 1 &&
+		v.Type().NumOut() == 0 && !v.IsNil() {
+		var elements []any
+		arg := reflect.MakeFunc(v.Type().In(0),
+			func(args []reflect.Value) []reflect.Value {
+				elements = append(elements, args[0].Interface())
+				return nil
+			})
+		v.Call([]reflect.Value{arg})
+		return elements
+	}
+	return x
+}
+
+// law is a property of the operators of linq over a sequence and an int.
+type law struct {
+	name string
+	prop func(x linq.Enumerator[int], k int) bool
+}
+
+var laws = []law{
+	{"Take(k).Count() <= max(k, 0)", func(x linq.Enumerator[int],
+		k int) bool {
+		n := x.Take(k).Count()
+		return n <= k || n == 0
+	}},
+	{"Take(k) ++ Skip(k) == x", func(x linq.Enumerator[int], k int) bool {
+		return reflect.DeepEqual(x.Take(k).Concat(x.Skip(k)).ToSlice(),
+			x.ToSlice())
+	}},
+	{"Select preserves length", func(x linq.Enumerator[int], k int) bool {
+		return linq.Select(func(e int) string { return fmt.Sprint(e * k) },
+			x).Count() == x.Count()
+	}},
+	{"Where(p).Count() <= Count()", func(x linq.Enumerator[int], k int) bool {
+		return x.Where(func(e int) bool { return e < k }).Count() <=
+			x.Count()
+	}},
+	{"Reverse().Reverse() == x", func(x linq.Enumerator[int], k int) bool {
+		return reflect.DeepEqual(x.Reverse().Reverse().ToSlice(),
+			x.ToSlice())
+	}},
+	{"Sum(x) == Sum(Shuffle(x))", func(x linq.Enumerator[int], k int) bool {
+		r := rand.New(rand.NewSource(int64(k)))
+		return linq.Sum(x) == linq.Sum(x.Shuffle(r))
+	}},
+}
+
+// CheckOperatorLaws checks some laws which the operators of linq should
+// obey, e.g. Take(k).Count() <= max(k, 0), against n arbitrary sequences
+// made with r, and reports each violated law to t.
+// It is meant to guard the package against regressions.
+func CheckOperatorLaws(t testing.TB, r *rand.Rand, n int) bool {
+	t.Helper()
+	ok := true
+	for _, l := range laws {
+		ok = ForAll(t, r, n, func(r *rand.Rand) arbitraryCase {
+			return arbitraryCase{ArbitraryInts(r, 20), r.Intn(25) - 2}
+		}, func(c arbitraryCase) bool {
+			if !l.prop(c.x, c.k) {
+				t.Errorf("law %q is violated", l.name)
+				return false
+			}
+			return true
+		}) && ok
+	}
+	return ok
+}
+
+// arbitraryCase is an argument of a law.
+type arbitraryCase struct {
+	x linq.Enumerator[int]
+	k int
+}
+
+func (c arbitraryCase) String() string {
+	return fmt.Sprintf("x = %v, k = %d", c.x.ToSlice(), c.k)
+}
@@ -0,0 +1,44 @@
+package linqtest
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/nukata/linq-in-go/linq"
+)
+
+func ExampleArbitraryInts() {
+	r := rand.New(rand.NewSource(1))
+	x := ArbitraryInts(r, 10)
+	fmt.Println(x.Count() <= 10, x.Count() == len(x.ToSlice()))
+	// Output:
+	// true true
+}
+
+func ExampleForAll() {
+	var t recorder
+	r := rand.New(rand.NewSource(1))
+	gen := func(r *rand.Rand) linq.Enumerator[int] {
+		return ArbitraryInts(r, 5)
+	}
+	fmt.Println(ForAll(t, r, 100, gen, func(x linq.Enumerator[int]) bool {
+		return x.Skip(1).Count() < x.Count() || x.Count() == 0
+	}))
+
+	// A wrong property is reported with a counterexample.
+	fmt.Println(ForAll(t, r, 100, gen, func(x linq.Enumerator[int]) bool {
+		return x.Count() < 5
+	}))
+	// Output:
+	// true
+	// property does not hold for [-22 75 93 80 -92]
+	// false
+}
+
+func ExampleCheckOperatorLaws() {
+	var t recorder
+	r := rand.New(rand.NewSource(1))
+	fmt.Println(CheckOperatorLaws(t, r, 200))
+	// Output:
+	// true
+}
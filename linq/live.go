@@ -0,0 +1,364 @@
+package linq
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// The operators in this file are meant for live sources, e.g. the ones
+// made by FromChan, whose elements arrive over time.
+// Each of them enumerates its source in another goroutine.
+
+// push starts enumerating loop in another goroutine and returns elements,
+// to which each element of loop is sent, and stop, which terminates the
+// enumeration.
+// elements is closed after loop runs out or stops.
+// If loop panics, elements is closed and failure returns the value.
+// stop may be called more than once.
+func push[T any](loop Enumerator[T]) (elements <-chan T, stop func(),
+	failure func() any) {
+	c := make(chan T)
+	quit := make(chan struct{})
+	var once sync.Once
+	var f any
+	go func() {
+		defer close(c)
+		defer func() {
+			f = recover()
+		}()
+		loop.LoopWithExit(func(element T, exit func()) {
+			select {
+			case c <- element:
+			case <-quit:
+				exit()
+			}
+		})
+	}()
+	stop = func() {
+		once.Do(func() {
+			close(quit)
+		})
+	}
+	failure = func() any {
+		return f // valid after c is closed
+	}
+	return c, stop, failure
+}
+
+// resetTimer makes t fire after d, whether t has fired or not.
+func resetTimer(t *time.Timer, d time.Duration) {
+	if !t.Stop() {
+		select {
+		case <-t.C:
+		default:
+		}
+	}
+	t.Reset(d)
+}
+
+// Debounce creates an Enumerator which yields an element only after d has
+// elapsed without a newer element arriving, e.g. collapsing a burst of
+// file-watcher events into the last one.
+// The last element is yielded without delay when loop runs out.
+// If loop panics, the enumerator will panic with the same value.
+func (loop Enumerator[T]) Debounce(d time.Duration) Enumerator[T] {
+	return func(yield func(T)) {
+		elements, stop, failure := push(loop)
+		defer stop()
+		timer := time.NewTimer(d)
+		timer.Stop()
+		var timeout <-chan time.Time // nil while nothing is pending
+		var pending T
+		for {
+			select {
+			case element, ok := <-elements:
+				if !ok {
+					if timeout != nil {
+						yield(pending)
+					}
+					if f := failure(); f != nil {
+						panic(f)
+					}
+					return
+				}
+				pending = element
+				resetTimer(timer, d)
+				timeout = timer.C
+			case <-timeout:
+				timeout = nil
+				yield(pending)
+			}
+		}
+	}
+}
+
+// Limiter is the interface of rate limiters for Throttle.
+// *rate.Limiter of golang.org/x/time/rate implements it.
+type Limiter interface {
+	// Wait blocks until an event is permitted, or returns an error if ctx
+	// is done before that.
+	Wait(ctx context.Context) error
+}
+
+// Throttle creates an Enumerator which waits for limiter before yielding
+// each element, e.g. to cap the rate of the outbound requests which a
+// pipeline drives:
+//
+//	limiter := rate.NewLimiter(rate.Limit(10), 5) // 10 per second
+//	loop.Throttle(ctx, limiter).ForEach(send)
+//
+// Since loop is enumerated on demand, it is also slowed down.
+// If Wait returns an error, e.g. ctx.Err(), the enumerator will panic with
+// it.
+func (loop Enumerator[T]) Throttle(ctx context.Context,
+	limiter Limiter) Enumerator[T] {
+	return func(yield func(T)) {
+		loop(func(element T) {
+			if err := limiter.Wait(ctx); err != nil {
+				panic(err)
+			}
+			yield(element)
+		})
+	}
+}
+
+// EmitEvery creates an Enumerator which yields each element at least d
+// after the previous one, sleeping as needed.
+// It is a simpler alternative to Throttle without bursts and contexts.
+func (loop Enumerator[T]) EmitEvery(d time.Duration) Enumerator[T] {
+	return func(yield func(T)) {
+		var last time.Time
+		loop(func(element T) {
+			if !last.IsZero() {
+				time.Sleep(time.Until(last.Add(d)))
+			}
+			last = time.Now()
+			yield(element)
+		})
+	}
+}
+
+// BufferTimeout creates an Enumerator which collects the elements of loop
+// into batches and yields each batch when it has maxCount elements or
+// maxWait has elapsed since its first element arrived, whichever comes
+// first; e.g. for batched writes from a live stream.
+// The last batch is yielded when loop runs out.
+// Each batch is a newly allocated slice.
+// If loop panics, the enumerator will panic with the same value.
+func BufferTimeout[T any](maxCount int, maxWait time.Duration,
+	loop Enumerator[T]) Enumerator[[]T] {
+	return func(yield func([]T)) {
+		elements, stop, failure := push(loop)
+		defer stop()
+		timer := time.NewTimer(maxWait)
+		timer.Stop()
+		var timeout <-chan time.Time // nil while the batch is empty
+		var batch []T
+		flush := func() {
+			timer.Stop()
+			timeout = nil
+			b := batch
+			batch = nil
+			yield(b)
+		}
+		for {
+			select {
+			case element, ok := <-elements:
+				if !ok {
+					if batch != nil {
+						flush()
+					}
+					if f := failure(); f != nil {
+						panic(f)
+					}
+					return
+				}
+				if batch == nil {
+					resetTimer(timer, maxWait)
+					timeout = timer.C
+				}
+				batch = append(batch, element)
+				if len(batch) >= maxCount {
+					flush()
+				}
+			case <-timeout:
+				flush()
+			}
+		}
+	}
+}
+
+// FromTicker creates an Enumerator[time.Time] which yields the time at
+// every d like time.Ticker, so that periodic work can be expressed as a
+// pipeline.
+// Unlike the other sources with ctx, the enumeration completes normally
+// when ctx is done, since it does not end otherwise.
+// The ticker is stopped when the enumeration completes or terminates
+// early.
+func FromTicker(ctx context.Context, d time.Duration) Enumerator[time.Time] {
+	return func(yield func(time.Time)) {
+		ticker := time.NewTicker(d)
+		defer ticker.Stop()
+		for {
+			select {
+			case t := <-ticker.C:
+				yield(t)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// After creates an Enumerator[time.Time] which waits for d and then yields
+// the current time, each time it is enumerated.
+func After(d time.Duration) Enumerator[time.Time] {
+	return func(yield func(time.Time)) {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		yield(<-timer.C)
+	}
+}
+
+// CombineLatest creates an Enumerator which yields f(x, y) whenever either
+// loop1 or loop2 produces an element, pairing it with the latest element
+// of the other, once both have produced one.
+// Unlike Zip, it suits live sources which run at different paces.
+// It completes when both of loop1 and loop2 run out.
+// If either of them panics, the enumerator will panic with the same value.
+func CombineLatest[T any, U any, R any](f func(T, U) R,
+	loop1 Enumerator[T], loop2 Enumerator[U]) Enumerator[R] {
+	return func(yield func(R)) {
+		c1, stop1, failure1 := push(loop1)
+		defer stop1()
+		c2, stop2, failure2 := push(loop2)
+		defer stop2()
+		var x T
+		var y U
+		hasX, hasY := false, false
+		for c1 != nil || c2 != nil {
+			select {
+			case e, ok := <-c1:
+				if !ok {
+					if f := failure1(); f != nil {
+						panic(f)
+					}
+					c1 = nil
+					continue
+				}
+				x, hasX = e, true
+			case e, ok := <-c2:
+				if !ok {
+					if f := failure2(); f != nil {
+						panic(f)
+					}
+					c2 = nil
+					continue
+				}
+				y, hasY = e, true
+			}
+			if hasX && hasY {
+				yield(f(x, y))
+			}
+		}
+	}
+}
+
+// WithLatestFrom creates an Enumerator which yields f(x, y) for each
+// element x of loop, where y is the latest element of other, e.g. to
+// enrich events with slowly changing reference data.
+// Only loop drives the emission; the elements of loop which arrive before
+// the first element of other are dropped.
+// It completes when loop runs out, and then stops other.
+// If either of them panics, the enumerator will panic with the same value.
+func WithLatestFrom[T any, U any, R any](f func(T, U) R,
+	loop Enumerator[T], other Enumerator[U]) Enumerator[R] {
+	return func(yield func(R)) {
+		c1, stop1, failure1 := push(loop)
+		defer stop1()
+		c2, stop2, failure2 := push(other)
+		defer stop2()
+		var y U
+		hasY := false
+		for {
+			select {
+			case x, ok := <-c1:
+				if !ok {
+					if f := failure1(); f != nil {
+						panic(f)
+					}
+					return
+				}
+				if hasY {
+					yield(f(x, y))
+				}
+			case e, ok := <-c2:
+				if !ok {
+					if f := failure2(); f != nil {
+						panic(f)
+					}
+					c2 = nil // Keep the latest one.
+					continue
+				}
+				y, hasY = e, true
+			}
+		}
+	}
+}
+
+// Race creates an Enumerator which enumerates loops concurrently and
+// commits to the one which produces the first element, stopping the
+// others; e.g. for hedged requests across mirrored data sources.
+// The loops which run out or panic without any element drop out of the
+// race.
+// If all of them do so, the enumerator will panic with the value of the
+// first panic if any, or yield nothing.
+// If the winner panics later, the enumerator will panic with the same
+// value.
+func Race[T any](loops ...Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		type first struct {
+			index   int
+			element T
+			ok      bool
+		}
+		n := len(loops)
+		cs := make([]<-chan T, n)
+		stops := make([]func(), n)
+		failures := make([]func() any, n)
+		firsts := make(chan first, n)
+		for i, loop := range loops {
+			cs[i], stops[i], failures[i] = push(loop)
+			defer stops[i]()
+			go func(i int) {
+				element, ok := <-cs[i]
+				firsts <- first{i, element, ok}
+			}(i)
+		}
+		var failure any
+		for ; n > 0; n-- {
+			f := <-firsts
+			if !f.ok {
+				if failure == nil {
+					failure = failures[f.index]()
+				}
+				continue
+			}
+			for i, stop := range stops {
+				if i != f.index {
+					stop()
+				}
+			}
+			yield(f.element)
+			for element := range cs[f.index] {
+				yield(element)
+			}
+			failure = failures[f.index]()
+			break
+		}
+		if failure != nil {
+			panic(failure)
+		}
+	}
+}
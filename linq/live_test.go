@@ -0,0 +1,200 @@
+package linq
+
+import (
+	"context"
+	"errors"
+	. "fmt"
+	"time"
+)
+
+// feed is a live source which an example drives step by step.
+type feed[T any] struct {
+	c     chan T
+	taken chan struct{}
+}
+
+func newFeed[T any]() *feed[T] {
+	return &feed[T]{make(chan T), make(chan struct{})}
+}
+
+// Enumerator creates an Enumerator which yields each element sent to f.
+func (f *feed[T]) Enumerator() Enumerator[T] {
+	return func(yield func(T)) {
+		for element := range f.c {
+			yield(element)
+			f.taken <- struct{}{}
+		}
+	}
+}
+
+// Send sends each of elements and waits until yield returns for it, i.e.
+// until the operator which enumerates f in another goroutine has received
+// it; thus the elements sent to several feeds arrive in order.
+func (f *feed[T]) Send(elements ...T) {
+	for _, element := range elements {
+		f.c <- element
+		<-f.taken
+	}
+}
+
+// Close makes the Enumerator of f run out.
+func (f *feed[T]) Close() {
+	close(f.c)
+}
+
+func ExampleEnumerator_Debounce() {
+	bursts := newFeed[string]()
+	yielded := make(chan struct{}, 2)
+	go func() {
+		bursts.Send("a", "b", "c")
+		<-yielded // The burst has settled.
+		bursts.Send("d", "e")
+		bursts.Close()
+	}()
+	x := bursts.Enumerator().Debounce(500 * time.Millisecond)
+	x.ForEach(func(s string) {
+		Println(s)
+		yielded <- struct{}{}
+	})
+	// Output:
+	// c
+	// e
+}
+
+// tokens is a Limiter which permits n events in total.
+type tokens struct{ n int }
+
+func (t *tokens) Wait(ctx context.Context) error {
+	if t.n == 0 {
+		return errors.New("no more tokens")
+	}
+	t.n--
+	return ctx.Err()
+}
+
+func ExampleEnumerator_Throttle() {
+	x := Range(1, 5).Throttle(context.Background(), &tokens{3})
+	for _, n := range Materialize(x).ToSlice() {
+		Println(n)
+	}
+	// Output:
+	// OnNext(1)
+	// OnNext(2)
+	// OnNext(3)
+	// OnError(no more tokens)
+}
+
+func ExampleEnumerator_EmitEvery() {
+	start := time.Now()
+	Println(Range(1, 4).EmitEvery(20 * time.Millisecond).ToSlice())
+	Println(time.Since(start) >= 60*time.Millisecond)
+	// Output:
+	// [1 2 3 4]
+	// true
+}
+
+func ExampleBufferTimeout() {
+	// 5 quick elements, a pause, and 2 more
+	elements := newFeed[int]()
+	yielded := make(chan struct{}, 3)
+	go func() {
+		elements.Send(1, 2, 3, 4, 5)
+		<-yielded // [1 2 3] by the count
+		<-yielded // [4 5] by the timeout
+		elements.Send(6, 7)
+		elements.Close()
+	}()
+	x := BufferTimeout(3, 500*time.Millisecond, elements.Enumerator())
+	x.ForEach(func(batch []int) {
+		Println(batch)
+		yielded <- struct{}{}
+	})
+	// Output:
+	// [1 2 3]
+	// [4 5]
+	// [6 7]
+}
+
+func ExampleFromTicker() {
+	ctx, cancel := context.WithTimeout(context.Background(),
+		time.Second)
+	defer cancel()
+	ticks := FromTicker(ctx, 10*time.Millisecond)
+	// tick → Select → Take
+	x := Select(func(time.Time) int { return 1 }, ticks)
+	Println(Sum(x.Take(3)))
+
+	// The enumeration completes when ctx is done.
+	cancel()
+	Println(ticks.Count())
+	// Output:
+	// 3
+	// 0
+}
+
+func ExampleAfter() {
+	start := time.Now()
+	t := After(20 * time.Millisecond).First().OrElse(time.Time{})
+	Println(t.Sub(start) >= 20*time.Millisecond)
+	// Output:
+	// true
+}
+
+func ExampleCombineLatest() {
+	prices, rates := newFeed[int](), newFeed[float64]()
+	go func() {
+		prices.Send(100)
+		rates.Send(1)
+		prices.Send(101)
+		rates.Send(2)
+		prices.Send(102)
+		prices.Close()
+		rates.Close()
+	}()
+	x := CombineLatest(func(p int, r float64) float64 {
+		return float64(p) * r
+	}, prices.Enumerator(), rates.Enumerator())
+	Println(x.ToSlice())
+	// Output:
+	// [100 101 202 204]
+}
+
+func ExampleWithLatestFrom() {
+	events, versions := newFeed[string](), newFeed[int]()
+	go func() {
+		events.Send("a") // dropped before any version
+		versions.Send(1)
+		events.Send("b")
+		versions.Send(2)
+		events.Send("c", "d")
+		events.Close()
+		versions.Close()
+	}()
+	x := WithLatestFrom(func(e string, v int) string {
+		return Sprint(e, v)
+	}, events.Enumerator(), versions.Enumerator())
+	Println(x.ToSlice())
+	// Output:
+	// [b1 c2 d2]
+}
+
+func ExampleRace() {
+	decided := make(chan struct{})
+	slow := Enumerator[string](func(yield func(string)) {
+		<-decided // It cannot win.
+		yield("slow1")
+		yield("slow2")
+	})
+	fast := From([]string{"fast1", "fast2", "fast3"})
+	empty := Empty[string]()
+	var result []string
+	Race(slow, fast, empty).ForEach(func(s string) {
+		if result == nil {
+			close(decided)
+		}
+		result = append(result, s)
+	})
+	Println(result)
+	// Output:
+	// [fast1 fast2 fast3]
+}
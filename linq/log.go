@@ -0,0 +1,35 @@
+//go:build go1.21
+
+package linq
+
+import (
+	"context"
+	"log/slog"
+)
+
+// Log creates an Enumerator which yields the elements of the sequence
+// unchanged and logs each of them with its index at debug level to logger
+// with msg, which names the stage.
+// It also logs the start and the end of each enumeration, with the number
+// of elements at the end.
+// If logger does not enable debug level, it logs nothing.
+func (loop Enumerator[T]) Log(logger *slog.Logger, msg string) Enumerator[T] {
+	return func(yield func(T)) {
+		ctx := context.Background()
+		if !logger.Enabled(ctx, slog.LevelDebug) {
+			loop(yield)
+			return
+		}
+		logger.DebugContext(ctx, msg, "event", "start")
+		n := 0
+		defer func() {
+			logger.DebugContext(ctx, msg, "event", "end", "count", n)
+		}()
+		loop(func(element T) {
+			logger.DebugContext(ctx, msg, "event", "element", "index", n,
+				"element", element)
+			n++
+			yield(element)
+		})
+	}
+}
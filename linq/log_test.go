@@ -0,0 +1,29 @@
+//go:build go1.21
+
+package linq
+
+import (
+	. "fmt"
+	"log/slog"
+	"os"
+)
+
+func ExampleEnumerator_Log() {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{} // for the stable output
+			}
+			return a
+		},
+	}))
+	x := Range(1, 5).Log(logger, "range").Take(2)
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// level=DEBUG msg=range event=start
+	// level=DEBUG msg=range event=element index=0 element=1
+	// level=DEBUG msg=range event=element index=1 element=2
+	// level=DEBUG msg=range event=end count=2
+	// [1 2]
+}
@@ -0,0 +1,101 @@
+package linq
+
+import "container/heap"
+
+// pull starts enumerating loop in another goroutine and returns next,
+// which returns the next element of loop on demand, and stop, which
+// terminates the enumeration.
+// next returns false as the second value after loop runs out.
+// If loop panics, next will panic with the same value.
+// stop must be called at most once; if it is not called, the goroutine
+// remains until loop runs out.
+func pull[T any](loop Enumerator[T]) (next func() (T, bool), stop func()) {
+	dataChan := make(chan T)
+	quitChan := make(chan bool, 1)
+	var failure any
+	go func() {
+		defer close(dataChan)
+		defer func() {
+			failure = recover()
+		}()
+		loop.LoopWithExit(func(element T, exit func()) {
+			if _, ok := <-quitChan; ok {
+				dataChan <- element
+			} else {
+				exit()
+			}
+		})
+	}()
+	done := false
+	next = func() (element T, ok bool) {
+		if !done {
+			quitChan <- true
+			element, ok = <-dataChan
+			if !ok {
+				done = true
+				if failure != nil {
+					panic(failure)
+				}
+			}
+		}
+		return
+	}
+	stop = func() {
+		close(quitChan)
+	}
+	return
+}
+
+// Pull adapts loop to the code which pulls elements one at a time.
+// It starts enumerating loop in another goroutine and returns next and
+// stop, which behave as described in pull.
+// Call stop when the elements are no longer needed, e.g. with defer.
+func Pull[T any](loop Enumerator[T]) (next func() (T, bool), stop func()) {
+	return pull(loop)
+}
+
+// mergeItem is the head element of the index-th sequence.
+type mergeItem[T any] struct {
+	element T
+	index   int
+}
+
+// MergeSorted creates an Enumerator which merges loops, each of which is
+// sorted according to less, into a single sorted sequence lazily.
+// Equal elements are yielded in the order of loops.
+// Each of loops is enumerated in its own goroutine and only the head
+// elements of them are held in a heap.
+func MergeSorted[T any](less func(a, b T) bool,
+	loops ...Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		nexts := make([]func() (T, bool), len(loops))
+		h := &Heap[mergeItem[T]]{less: func(a, b mergeItem[T]) bool {
+			if less(a.element, b.element) {
+				return true
+			}
+			if less(b.element, a.element) {
+				return false
+			}
+			return a.index < b.index // Keep the merge stable.
+		}}
+		for i, loop := range loops {
+			next, stop := pull(loop)
+			defer stop()
+			nexts[i] = next
+			if element, ok := next(); ok {
+				h.Elements = append(h.Elements, mergeItem[T]{element, i})
+			}
+		}
+		heap.Init(h)
+		for h.Len() > 0 {
+			item := h.Elements[0]
+			yield(item.element)
+			if element, ok := nexts[item.index](); ok {
+				h.Elements[0].element = element
+				heap.Fix(h, 0)
+			} else {
+				heap.Pop(h)
+			}
+		}
+	}
+}
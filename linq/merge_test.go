@@ -0,0 +1,40 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+func ExampleMergeSorted() {
+	less := func(a, b int) bool { return a < b }
+	x := MergeSorted(less,
+		From([]int{1, 4, 7}),
+		From([]int{2, 5, 8, 9}),
+		Empty[int](),
+		From([]int{0, 3, 6}))
+	Printf("%v\n", x.ToSlice())
+
+	// It works lazily for infinite sequences.
+	evens := RangeBy(0, 1<<62, 2)
+	threes := RangeBy(0, 1<<62, 3)
+	y := MergeSorted(less, evens, threes).Take(8)
+	Printf("%v\n", y.ToSlice())
+	// Output:
+	// [0 1 2 3 4 5 6 7 8 9]
+	// [0 0 2 3 4 6 6 8]
+}
+
+func ExamplePull() {
+	next, stop := Pull(Range(1, 3))
+	defer stop()
+	for {
+		x, ok := next()
+		if !ok {
+			break
+		}
+		Println(x)
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+}
@@ -0,0 +1,110 @@
+package linq
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Stats is the statistics of a stage of a pipeline, recorded by
+// Instrument.
+type Stats struct {
+	Name         string
+	Enumerations int64         // how many times the stage was enumerated
+	Elements     int64         // how many elements passed through
+	Duration     time.Duration // the cumulative time spent upstream
+}
+
+// Metrics collects the Stats of the stages of pipelines.
+// It is safe for concurrent use.
+// It implements expvar.Var; you can publish it by expvar.Publish.
+type Metrics struct {
+	mu     sync.Mutex
+	stages map[string]*stageStats
+	order  []string
+}
+
+// stageStats holds the counters of a stage, updated atomically.
+type stageStats struct {
+	enumerations int64
+	elements     int64
+	duration     int64 // in nanoseconds
+}
+
+// NewMetrics creates an empty Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{stages: make(map[string]*stageStats)}
+}
+
+func (m *Metrics) stage(name string) *stageStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	s, ok := m.stages[name]
+	if !ok {
+		s = new(stageStats)
+		m.stages[name] = s
+		m.order = append(m.order, name)
+	}
+	return s
+}
+
+// Stats returns a snapshot of the Stats of the stages in the order of
+// their first instrumentation.
+func (m *Metrics) Stats() []Stats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make([]Stats, len(m.order))
+	for i, name := range m.order {
+		s := m.stages[name]
+		result[i] = Stats{
+			Name:         name,
+			Enumerations: atomic.LoadInt64(&s.enumerations),
+			Elements:     atomic.LoadInt64(&s.elements),
+			Duration:     time.Duration(atomic.LoadInt64(&s.duration)),
+		}
+	}
+	return result
+}
+
+// String returns the Stats in JSON.
+func (m *Metrics) String() string {
+	b, err := json.Marshal(m.Stats())
+	if err != nil {
+		return "null"
+	}
+	return string(b)
+}
+
+// Instrument creates an Enumerator which yields the elements of the
+// sequence unchanged and records them into m as the stage named name.
+// Its Duration is the time spent in the sequence, i.e. in all the stages
+// upstream, excluding the time spent downstream; thus the difference of
+// Duration between adjacent stages shows the cost of the stage between.
+// Several Enumerators may share the same name to be recorded together.
+// The counters are updated each time an enumeration ends.
+func (loop Enumerator[T]) Instrument(m *Metrics, name string) Enumerator[T] {
+	s := m.stage(name)
+	return func(yield func(T)) {
+		atomic.AddInt64(&s.enumerations, 1)
+		var n int64
+		var upstream time.Duration
+		inYield := false
+		last := time.Now()
+		defer func() {
+			if !inYield {
+				upstream += time.Since(last)
+			}
+			atomic.AddInt64(&s.elements, n)
+			atomic.AddInt64(&s.duration, int64(upstream))
+		}()
+		loop(func(element T) {
+			upstream += time.Since(last)
+			n++
+			inYield = true
+			yield(element)
+			inYield = false
+			last = time.Now()
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package linq
+
+import (
+	. "fmt"
+	"time"
+)
+
+func ExampleEnumerator_Instrument() {
+	m := NewMetrics()
+	source := Range(1, 10).Instrument(m, "source")
+	slow := Select(func(i int) int {
+		time.Sleep(time.Millisecond)
+		return i * i
+	}, source).Instrument(m, "select")
+	x := slow.Where(func(i int) bool { return i%2 == 0 }).Instrument(m, "where")
+	Printf("%v\n", x.ToSlice())
+
+	for _, s := range m.Stats() {
+		Println(s.Name, s.Enumerations, s.Elements)
+	}
+	stats := m.Stats()
+	Println(stats[1].Duration-stats[0].Duration >= 10*time.Millisecond)
+	// Output:
+	// [4 16 36 64 100]
+	// source 1 10
+	// select 1 10
+	// where 1 5
+	// true
+}
+
+func ExampleMetrics_String() {
+	m := NewMetrics()
+	Range(1, 3).Instrument(m, "range").Take(2).ToSlice()
+	s := m.Stats()[0]
+	Println(s.Name, s.Elements)
+	Println(len(m.String()) > 0)
+	// Output:
+	// range 2
+	// true
+}
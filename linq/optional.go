@@ -0,0 +1,140 @@
+package linq
+
+import "fmt"
+
+// Optional represents a value which may be absent.
+// It distinguishes a present zero value from the absence of a value.
+// The zero value of Optional is absent.
+type Optional[T any] struct {
+	value   T
+	present bool
+}
+
+// Some creates an Optional which has x.
+func Some[T any](x T) Optional[T] {
+	return Optional[T]{x, true}
+}
+
+// None creates an Optional which has no value.
+func None[T any]() Optional[T] {
+	return Optional[T]{}
+}
+
+// optional creates an Optional from the pair of values such as the results
+// of Reduce.
+func optional[T any](x T, ok bool) Optional[T] {
+	if ok {
+		return Some(x)
+	}
+	return None[T]()
+}
+
+// IsPresent reports whether o has a value.
+func (o Optional[T]) IsPresent() bool {
+	return o.present
+}
+
+// Get returns the value of o and whether it is present.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.present
+}
+
+// OrElse returns the value of o if it is present, or other otherwise.
+func (o Optional[T]) OrElse(other T) T {
+	if o.present {
+		return o.value
+	}
+	return other
+}
+
+// Map returns an Optional of f(value) if o has a value, or o otherwise.
+// See MapOptional for f which changes the type of the value.
+func (o Optional[T]) Map(f func(T) T) Optional[T] {
+	return MapOptional(f, o)
+}
+
+// String returns a string such as "Some(1)" or "None".
+func (o Optional[T]) String() string {
+	if o.present {
+		return fmt.Sprintf("Some(%v)", o.value)
+	}
+	return "None"
+}
+
+// MapOptional returns an Optional of f(value) if o has a value, or an
+// absent Optional otherwise.
+func MapOptional[T any, R any](f func(T) R, o Optional[T]) Optional[R] {
+	if o.present {
+		return Some(f(o.value))
+	}
+	return None[R]()
+}
+
+// First returns the first element of the sequence, or an absent Optional if
+// the sequence is empty.
+// It stops the enumeration at the first element.
+func (loop Enumerator[T]) First() Optional[T] {
+	var result Optional[T]
+	loop.LoopWithExit(func(element T, exit func()) {
+		result = Some(element)
+		exit()
+	})
+	return result
+}
+
+// Last returns the last element of the sequence, or an absent Optional if
+// the sequence is empty.
+func (loop Enumerator[T]) Last() Optional[T] {
+	var result Optional[T]
+	loop(func(element T) {
+		result = Some(element)
+	})
+	return result
+}
+
+// ElementAt returns the element at index of the sequence, counting from 0,
+// or an absent Optional if there is no such element.
+// It stops the enumeration at the element.
+func (loop Enumerator[T]) ElementAt(index int) Optional[T] {
+	var result Optional[T]
+	if index < 0 {
+		return result
+	}
+	i := 0
+	loop.LoopWithExit(func(element T, exit func()) {
+		if i == index {
+			result = Some(element)
+			exit()
+		}
+		i++
+	})
+	return result
+}
+
+// Single returns the only element of the sequence, or an absent Optional if
+// the sequence does not have exactly one element.
+// It stops the enumeration at the second element.
+func (loop Enumerator[T]) Single() Optional[T] {
+	var result Optional[T]
+	n := 0
+	loop.LoopWithExit(func(element T, exit func()) {
+		n++
+		if n > 1 {
+			result = None[T]()
+			exit()
+			return
+		}
+		result = Some(element)
+	})
+	return result
+}
+
+// MinOptional is a variant of Min which returns an Optional.
+func MinOptional[T Ordered](loop Enumerator[T]) Optional[T] {
+	return optional(Min(loop))
+}
+
+// MaxOptional is a variant of Max which returns an Optional.
+func MaxOptional[T Ordered](loop Enumerator[T]) Optional[T] {
+	return optional(Max(loop))
+}
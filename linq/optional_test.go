@@ -0,0 +1,70 @@
+package linq
+
+import (
+	. "fmt"
+	"strings"
+)
+
+func ExampleOptional() {
+	x := Some(0)
+	y := None[int]()
+	Println(x, x.IsPresent(), x.OrElse(-1))
+	Println(y, y.IsPresent(), y.OrElse(-1))
+
+	v, ok := x.Map(func(i int) int { return i + 10 }).Get()
+	Println(v, ok)
+	// Output:
+	// Some(0) true 0
+	// None false -1
+	// 10 true
+}
+
+func ExampleMapOptional() {
+	x := MapOptional(strings.ToUpper, Some("abc"))
+	y := MapOptional(func(i int) string { return Sprint(i) }, None[int]())
+	Println(x, y)
+	// Output:
+	// Some(ABC) None
+}
+
+func ExampleEnumerator_First() {
+	Println(IntsFrom(1).Where(func(i int) bool { return i%7 == 0 }).First())
+	Println(Empty[int]().First())
+	// Output:
+	// Some(7)
+	// None
+}
+
+func ExampleEnumerator_Last() {
+	Println(Range(1, 5).Last())
+	// Output:
+	// Some(5)
+}
+
+func ExampleEnumerator_Single() {
+	Println(From([]string{"x"}).Single())
+	Println(From([]string{"x", "y"}).Single())
+	Println(IntsFrom(0).Single())
+	// Output:
+	// Some(x)
+	// None
+	// None
+}
+
+func ExampleMinOptional() {
+	Println(MinOptional(From([]int{0, 3})), MinOptional(Empty[int]()))
+	// Output:
+	// Some(0) None
+}
+
+func ExampleMaxOptional() {
+	Println(MaxOptional(From([]int{0, 3})), MaxOptional(Empty[int]()))
+	// Output:
+	// Some(3) None
+}
+
+func ExampleEnumerator_ElementAt() {
+	Println(IntsFrom(10).ElementAt(3), Range(1, 3).ElementAt(3))
+	// Output:
+	// Some(13) None
+}
@@ -0,0 +1,502 @@
+package linq
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// runWorkers enumerates loop in the current goroutine and distributes its
+// elements to n workers, each of which runs work(w, elements) in its own
+// goroutine, where w is 0, 1, ..., n-1.
+// It returns after all the workers return.
+// If a worker panics, the enumeration will terminate and runWorkers will
+// panic with the same value after the other workers return.
+func runWorkers[T any](n int, loop Enumerator[T],
+	work func(w int, elements <-chan T)) {
+	elements := make(chan T)
+	quit := make(chan struct{})
+	var once sync.Once
+	var failure any
+	var wg sync.WaitGroup
+	for w := 0; w < n; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() {
+						failure = r
+						close(quit)
+					})
+					for range elements { // Drain elements not to block.
+					}
+				}
+			}()
+			work(w, elements)
+		}(w)
+	}
+	func() {
+		defer func() {
+			close(elements)
+			wg.Wait()
+		}()
+		loop.LoopWithExit(func(element T, exit func()) {
+			select {
+			case elements <- element:
+			case <-quit:
+				exit()
+			}
+		})
+	}()
+	if failure != nil {
+		panic(failure)
+	}
+}
+
+// workers returns n if it is positive, or runtime.GOMAXPROCS(0) otherwise.
+func workers(n int) int {
+	if n > 0 {
+		return n
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// ParAggregate folds the elements of loop with n workers in parallel.
+// Each worker folds the elements dealt to it into its own accumulator, which
+// starts with seedFactory(), by fold.
+// The results of the workers are combined into one by combine.
+// Since the elements are dealt to the workers in no particular order, fold
+// and combine should be associative and commutative, e.g. sums.
+// If n is less than 1, runtime.GOMAXPROCS(0) workers are used.
+// The sequence itself is enumerated in the current goroutine.
+func ParAggregate[S any, T any](seedFactory func() S, fold func(S, T) S,
+	combine func(S, S) S, n int, loop Enumerator[T]) S {
+	n = workers(n)
+	partials := make([]S, n)
+	runWorkers(n, loop, func(w int, elements <-chan T) {
+		acc := seedFactory()
+		for element := range elements {
+			acc = fold(acc, element)
+		}
+		partials[w] = acc
+	})
+	result := partials[0]
+	for _, partial := range partials[1:] {
+		result = combine(result, partial)
+	}
+	return result
+}
+
+// ParallelQuery represents a sequence whose elements are processed by
+// several goroutines in parallel.
+// Create it with AsParallel.
+type ParallelQuery[T any] struct {
+	loop        Enumerator[T]
+	ordered     bool
+	workers     int
+	chunkSize   int
+	bufferLimit int
+	partitioner Partitioner
+}
+
+// AsParallel creates a ParallelQuery from loop.
+// By default, it is unordered, uses runtime.GOMAXPROCS(0) workers, deals
+// the elements to the workers one by one and does not limit the buffering.
+func AsParallel[T any](loop Enumerator[T]) ParallelQuery[T] {
+	return ParallelQuery[T]{loop: loop}
+}
+
+// AsOrdered returns a copy of q whose results are yielded in the order of
+// the source elements.
+// It costs the buffering of results which are finished ahead of their
+// turn.
+func (q ParallelQuery[T]) AsOrdered() ParallelQuery[T] {
+	q.ordered = true
+	return q
+}
+
+// AsUnordered returns a copy of q whose results are yielded as soon as
+// the workers finish them.
+func (q ParallelQuery[T]) AsUnordered() ParallelQuery[T] {
+	q.ordered = false
+	return q
+}
+
+// AsSequential returns the source sequence of q.
+func (q ParallelQuery[T]) AsSequential() Enumerator[T] {
+	return q.loop
+}
+
+// WithDegreeOfParallelism returns a copy of q which uses n workers.
+// If n is less than 1, runtime.GOMAXPROCS(0) workers are used.
+func (q ParallelQuery[T]) WithDegreeOfParallelism(n int) ParallelQuery[T] {
+	q.workers = n
+	return q
+}
+
+// WithChunkSize returns a copy of q which deals k elements at a time to
+// each worker.
+// A larger k reduces the overhead of communication for cheap functions,
+// while a smaller k balances the load better for expensive ones.
+// If k is less than 1, it is treated as 1.
+func (q ParallelQuery[T]) WithChunkSize(k int) ParallelQuery[T] {
+	q.chunkSize = k
+	return q
+}
+
+// WithBufferLimit returns a copy of q which holds at most m chunks of
+// elements at a time, from when they are taken from the source until their
+// results have been yielded, including the results waiting for their turn
+// in the ordered mode.
+// It bounds the memory at the cost of throughput.
+// If m is less than 1, the buffering is not limited.
+func (q ParallelQuery[T]) WithBufferLimit(m int) ParallelQuery[T] {
+	q.bufferLimit = m
+	return q
+}
+
+// Partitioner is a strategy to deal the elements to the workers.
+type Partitioner int
+
+const (
+	// StaticPartitioner deals each chunk of elements to a worker, which
+	// processes the whole chunk by itself.
+	StaticPartitioner Partitioner = iota
+
+	// WorkStealingPartitioner deals each chunk of elements to a worker, too.
+	// However, an idle worker steals the latter half of the unprocessed
+	// elements of a busy worker, so that a slow element does not stall
+	// the rest of its chunk.
+	// It suits functions whose cost varies widely from element to element.
+	WorkStealingPartitioner
+)
+
+// WithPartitioner returns a copy of q which deals the elements to the
+// workers by p.
+// The default is StaticPartitioner.
+func (q ParallelQuery[T]) WithPartitioner(p Partitioner) ParallelQuery[T] {
+	q.partitioner = p
+	return q
+}
+
+// chunk is a slice of contiguous source elements, or of their results.
+type chunk[T any] struct {
+	start    int // the index of the first source element
+	count    int // the number of source elements which the chunk covers
+	elements []T
+	lease    *lease
+}
+
+// lease represents a token of the buffer limit, which a source chunk
+// holds until all its elements are yielded.
+// It is shared by the parts of the chunk split by stealing.
+type lease struct {
+	remaining int32 // the number of source elements not yet yielded
+}
+
+// stealQueue holds the unprocessed elements of a worker.
+type stealQueue[T any] struct {
+	sync.Mutex
+	c chunk[T]
+}
+
+// parallelSelect applies f to each element of q in parallel and yields
+// each result r for which f returns (r, true).
+// The source sequence is enumerated and f is called in other goroutines,
+// while yield is called in the current goroutine.
+func parallelSelect[T any, R any](q ParallelQuery[T], f func(T) (R, bool),
+	yield func(R)) {
+	n := workers(q.workers)
+	size := q.chunkSize
+	if size < 1 {
+		size = 1
+	}
+	in := make(chan chunk[T])
+	out := make(chan chunk[R])
+	var tokens chan struct{} // a semaphore to limit the buffering
+	if q.bufferLimit > 0 {
+		tokens = make(chan struct{}, q.bufferLimit)
+	}
+	quit := make(chan struct{})
+	var once sync.Once
+	var failure any
+	fail := func() {
+		if r := recover(); r != nil {
+			once.Do(func() {
+				failure = r
+				close(quit)
+			})
+		}
+	}
+	stop := func() {
+		once.Do(func() {
+			close(quit)
+		})
+	}
+	defer stop() // Stop the goroutines if yield terminates early.
+
+	go func() {
+		defer close(in)
+		defer fail()
+		i := 0
+		var elements []T
+		send := func() bool {
+			var l *lease
+			if tokens != nil {
+				select {
+				case tokens <- struct{}{}:
+					l = &lease{int32(len(elements))}
+				case <-quit:
+					return false
+				}
+			}
+			select {
+			case in <- chunk[T]{i, len(elements), elements, l}:
+				i += len(elements)
+				elements = nil
+				return true
+			case <-quit:
+				return false
+			}
+		}
+		q.loop.LoopWithExit(func(element T, exit func()) {
+			elements = append(elements, element)
+			if len(elements) == size && !send() {
+				exit()
+			}
+		})
+		if elements != nil {
+			send()
+		}
+	}()
+	send := func(c chunk[R]) bool {
+		select {
+		case out <- c:
+			return true
+		case <-quit:
+			return false
+		}
+	}
+	var work func(w int)
+	if q.partitioner == WorkStealingPartitioner {
+		work = stealingWorker(n, in, quit, f, send)
+	} else {
+		work = func(int) {
+			for c := range in {
+				results := make([]R, 0, len(c.elements))
+				for _, element := range c.elements {
+					if r, ok := f(element); ok {
+						results = append(results, r)
+					}
+				}
+				if !send(chunk[R]{c.start, c.count, results, c.lease}) {
+					return
+				}
+			}
+		}
+	}
+	var wg sync.WaitGroup
+	for w := 0; w < n; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			defer fail()
+			work(w)
+		}(w)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	emit := func(c chunk[R]) {
+		for _, r := range c.elements {
+			yield(r)
+		}
+		// Release the token after yielding, so that the results being
+		// yielded count toward the limit.
+		if c.lease != nil {
+			if atomic.AddInt32(&c.lease.remaining, -int32(c.count)) == 0 {
+				<-tokens
+			}
+		}
+	}
+	if q.ordered {
+		pending := make(map[int]chunk[R])
+		next := 0
+		for c := range out {
+			pending[c.start] = c
+			for {
+				c, ok := pending[next]
+				if !ok {
+					break
+				}
+				delete(pending, next)
+				next += c.count
+				emit(c)
+			}
+		}
+	} else {
+		for c := range out {
+			emit(c)
+		}
+	}
+	if failure != nil {
+		panic(failure)
+	}
+}
+
+// stealingWorker returns the body of the w-th worker of n for
+// WorkStealingPartitioner.
+// Each worker takes chunks from in and sends the result of each element
+// by send.
+func stealingWorker[T any, R any](n int, in <-chan chunk[T],
+	quit <-chan struct{}, f func(T) (R, bool),
+	send func(chunk[R]) bool) func(w int) {
+	queues := make([]stealQueue[T], n)
+	var mu sync.Mutex
+	wake := make(chan struct{}) // closed when some elements may be stolen
+	signal := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		close(wake)
+		wake = make(chan struct{})
+	}
+	waiting := func() <-chan struct{} {
+		mu.Lock()
+		defer mu.Unlock()
+		return wake
+	}
+	// drained counts the workers which have seen in closed.
+	// Until all of them have, some chunk may be about to be put.
+	var drained int32
+	// pop removes the first unprocessed element of the w-th worker.
+	pop := func(w int) (c chunk[T], ok bool) {
+		qw := &queues[w]
+		qw.Lock()
+		defer qw.Unlock()
+		if len(qw.c.elements) == 0 {
+			return c, false
+		}
+		c = chunk[T]{qw.c.start, 1, qw.c.elements[:1], qw.c.lease}
+		qw.c.start++
+		qw.c.elements = qw.c.elements[1:]
+		if len(qw.c.elements) >= 2 {
+			signal()
+		}
+		return c, true
+	}
+	// steal moves the latter half of the unprocessed elements of another
+	// worker to the w-th worker.
+	steal := func(w int) bool {
+		for v := range queues {
+			if v == w {
+				continue
+			}
+			qv := &queues[v]
+			qv.Lock()
+			r := len(qv.c.elements)
+			if r >= 2 {
+				mid := r - r/2
+				stolen := chunk[T]{qv.c.start + mid, r - mid,
+					qv.c.elements[mid:], qv.c.lease}
+				qv.c.elements = qv.c.elements[:mid]
+				qv.Unlock()
+				put(&queues[w], stolen)
+				if r-mid >= 2 {
+					signal()
+				}
+				return true
+			}
+			qv.Unlock()
+		}
+		return false
+	}
+	return func(w int) {
+		inOpen := true
+		closeIn := func() {
+			inOpen = false
+			atomic.AddInt32(&drained, 1)
+			signal()
+		}
+		for {
+			woken := waiting()
+			if c, ok := pop(w); ok {
+				var results []R
+				if r, ok := f(c.elements[0]); ok {
+					results = []R{r}
+				}
+				if !send(chunk[R]{c.start, 1, results, c.lease}) {
+					return
+				}
+				continue
+			}
+			if inOpen {
+				select {
+				case c, ok := <-in:
+					if ok {
+						put(&queues[w], c)
+						continue
+					}
+					closeIn()
+				default:
+				}
+			}
+			if steal(w) {
+				continue
+			}
+			if !inOpen && atomic.LoadInt32(&drained) == int32(n) {
+				return
+			}
+			if inOpen {
+				select {
+				case c, ok := <-in:
+					if ok {
+						put(&queues[w], c)
+					} else {
+						closeIn()
+					}
+				case <-woken:
+				case <-quit:
+					return
+				}
+			} else {
+				select {
+				case <-woken:
+				case <-quit:
+					return
+				}
+			}
+		}
+	}
+}
+
+func put[T any](q *stealQueue[T], c chunk[T]) {
+	q.Lock()
+	defer q.Unlock()
+	q.c = c
+}
+
+// ParSelect creates an Enumerator which applies f to each element of q in
+// parallel.
+// If q is unordered, the results are yielded as soon as they are ready.
+// If f or the source of q panics, the enumerator will panic with the same
+// value.
+func ParSelect[T any, R any](f func(T) R, q ParallelQuery[T]) Enumerator[R] {
+	return func(yield func(R)) {
+		parallelSelect(q, func(element T) (R, bool) {
+			return f(element), true
+		}, yield)
+	}
+}
+
+// Where creates an Enumerator which selects the elements of q by applying
+// predicate to each of them in parallel.
+func (q ParallelQuery[T]) Where(predicate func(T) bool) Enumerator[T] {
+	return func(yield func(T)) {
+		parallelSelect(q, func(element T) (T, bool) {
+			return element, predicate(element)
+		}, yield)
+	}
+}
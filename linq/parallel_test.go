@@ -0,0 +1,193 @@
+package linq
+
+import (
+	. "fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+func ExampleParAggregate() {
+	sum := ParAggregate(func() int { return 0 },
+		func(acc, i int) int { return acc + i*i },
+		func(a, b int) int { return a + b }, 4, Range(1, 1000))
+	Println(sum)
+
+	histogram := ParAggregate(func() map[rune]int { return map[rune]int{} },
+		func(acc map[rune]int, c rune) map[rune]int {
+			acc[c]++
+			return acc
+		},
+		func(a, b map[rune]int) map[rune]int {
+			for c, n := range b {
+				a[c] += n
+			}
+			return a
+		}, 0, FromString(strings.Repeat("abracadabra", 100)))
+	Println(histogram)
+	// Output:
+	// 333833500
+	// map[97:500 98:200 99:100 100:100 114:200]
+}
+
+func ExampleParAggregate_panic() {
+	defer func() {
+		Println("recovered:", recover())
+	}()
+	ParAggregate(func() int { return 0 },
+		func(acc, i int) int {
+			if i == 500 {
+				panic("poi")
+			}
+			return acc + i
+		},
+		func(a, b int) int { return a + b }, 4, IntsFrom(1))
+	// Output:
+	// recovered: poi
+}
+
+func ExampleParallelQuery_AsOrdered() {
+	slowSquare := func(i int) int {
+		time.Sleep(time.Duration(10-i) * time.Millisecond)
+		return i * i
+	}
+	x := ParSelect(slowSquare, AsParallel(Range(1, 9)).AsOrdered())
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [1 4 9 16 25 36 49 64 81]
+}
+
+func ExampleParallelQuery_AsUnordered() {
+	q := AsParallel(Range(1, 100)).AsOrdered().AsUnordered()
+	x := ParSelect(func(i int) int { return i * 2 }, q)
+	Println(x.Count(), Sum(x))
+	// Output:
+	// 100 10100
+}
+
+func ExampleParallelQuery_AsSequential() {
+	q := AsParallel(Range(1, 3))
+	Printf("%v\n", q.AsSequential().ToSlice())
+	// Output:
+	// [1 2 3]
+}
+
+func ExampleParSelect() {
+	// Take terminates the enumeration early even for an infinite sequence.
+	q := AsParallel(IntsFrom(1)).AsOrdered()
+	x := ParSelect(func(i int) string { return Sprint(i) }, q).Take(5)
+	Printf("%q\n", x.ToSlice())
+	// Output:
+	// ["1" "2" "3" "4" "5"]
+}
+
+func ExampleParallelQuery_Where() {
+	isPrime := func(n int) bool {
+		for i := 2; i*i <= n; i++ {
+			if n%i == 0 {
+				return false
+			}
+		}
+		return n > 1
+	}
+	x := AsParallel(Range(1, 30)).AsOrdered().Where(isPrime)
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [2 3 5 7 11 13 17 19 23 29]
+}
+
+func ExampleParallelQuery_WithDegreeOfParallelism() {
+	var mu sync.Mutex
+	running, peak := 0, 0
+	q := AsParallel(Range(1, 20)).WithDegreeOfParallelism(3)
+	x := ParSelect(func(i int) int {
+		mu.Lock()
+		running++
+		if running > peak {
+			peak = running
+		}
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return i
+	}, q)
+	Println(Sum(x), peak <= 3)
+	// Output:
+	// 210 true
+}
+
+func ExampleParallelQuery_WithChunkSize() {
+	q := AsParallel(Range(1, 10000)).WithChunkSize(256).AsOrdered()
+	x := ParSelect(func(i int) int { return i % 7 }, q)
+	Println(x.Count(), IsSorted(x), Sum(x))
+	// Output:
+	// 10000 false 29998
+}
+
+func ExampleParallelQuery_WithBufferLimit() {
+	// More workers than the limit, so that the limit bounds the results.
+	q := AsParallel(IntsFrom(0)).AsOrdered().WithDegreeOfParallelism(8).
+		WithChunkSize(1).WithBufferLimit(4)
+	var mu sync.Mutex
+	outstanding, peak := 0, 0 // the results taken but not yet yielded
+	x := ParSelect(func(i int) int {
+		mu.Lock()
+		outstanding++
+		if outstanding > peak {
+			peak = outstanding
+		}
+		mu.Unlock()
+		if i == 0 {
+			time.Sleep(10 * time.Millisecond) // The others must wait.
+		}
+		return i
+	}, q).Tap(func(int) {
+		mu.Lock()
+		outstanding--
+		mu.Unlock()
+	})
+	Printf("%v\n", x.Take(6).ToSlice())
+	Println("peak", peak)
+	// Output:
+	// [0 1 2 3 4 5]
+	// peak 4
+}
+
+// skewed returns a function under which element 0 waits until n other
+// elements have been processed.
+func skewed(n int) func(int) int {
+	var mu sync.Mutex
+	rest := n
+	done := make(chan struct{})
+	return func(i int) int {
+		if i == 0 {
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				Println("stalled")
+			}
+			return i
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if rest--; rest == 0 {
+			close(done)
+		}
+		return i
+	}
+}
+
+func ExampleParallelQuery_WithPartitioner() {
+	q := AsParallel(Range(0, 100)).WithDegreeOfParallelism(2).
+		WithChunkSize(100).WithPartitioner(WorkStealingPartitioner)
+	// The other worker steals all but the last element of the only chunk.
+	Println(Sum(ParSelect(skewed(98), q)))
+
+	x := ParSelect(skewed(98), q.AsOrdered()).ToSlice()
+	Println(IsSorted(From(x)))
+	// Output:
+	// 4950
+	// true
+}
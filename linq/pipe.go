@@ -0,0 +1,84 @@
+package linq
+
+// Since a method cannot have its own type parameters in Go, operators
+// which change the element type, e.g. Select, are functions and their
+// nested calls read inside-out.
+// The functions below apply such operators to a sequence left-to-right.
+
+// Op represents an operator which transforms a sequence into another.
+// An Op can be named, composed and applied to many sequences.
+type Op[T any, R any] func(Enumerator[T]) Enumerator[R]
+
+// Apply applies op to loop.
+func Apply[T any, R any](loop Enumerator[T], op Op[T, R]) Enumerator[R] {
+	return op(loop)
+}
+
+// Apply2 applies op1 and op2 to loop in this order.
+func Apply2[T any, U any, R any](loop Enumerator[T],
+	op1 Op[T, U], op2 Op[U, R]) Enumerator[R] {
+	return op2(op1(loop))
+}
+
+// Apply3 applies op1, op2 and op3 to loop in this order.
+func Apply3[T any, U any, V any, R any](loop Enumerator[T],
+	op1 Op[T, U], op2 Op[U, V], op3 Op[V, R]) Enumerator[R] {
+	return op3(op2(op1(loop)))
+}
+
+// Apply4 applies op1, op2, op3 and op4 to loop in this order.
+func Apply4[T any, U any, V any, W any, R any](loop Enumerator[T],
+	op1 Op[T, U], op2 Op[U, V], op3 Op[V, W],
+	op4 Op[W, R]) Enumerator[R] {
+	return op4(op3(op2(op1(loop))))
+}
+
+// Compose creates an Op which applies op1 and op2 in this order.
+func Compose[T any, U any, R any](op1 Op[T, U], op2 Op[U, R]) Op[T, R] {
+	return func(loop Enumerator[T]) Enumerator[R] {
+		return op2(op1(loop))
+	}
+}
+
+// Pipe applies ops to loop in order.
+func Pipe[T any](loop Enumerator[T], ops ...Op[T, T]) Enumerator[T] {
+	for _, op := range ops {
+		loop = op(loop)
+	}
+	return loop
+}
+
+// MapOp creates an Op which does Select with f.
+func MapOp[T any, R any](f func(T) R) Op[T, R] {
+	return func(loop Enumerator[T]) Enumerator[R] {
+		return Select(f, loop)
+	}
+}
+
+// FlatMapOp creates an Op which does SelectMany with f.
+func FlatMapOp[T any, R any](f func(T) Enumerator[R]) Op[T, R] {
+	return func(loop Enumerator[T]) Enumerator[R] {
+		return SelectMany(f, loop)
+	}
+}
+
+// FilterOp creates an Op which does Where with predicate.
+func FilterOp[T any](predicate func(T) bool) Op[T, T] {
+	return func(loop Enumerator[T]) Enumerator[T] {
+		return loop.Where(predicate)
+	}
+}
+
+// TakeOp creates an Op which does Take with n.
+func TakeOp[T any](n int) Op[T, T] {
+	return func(loop Enumerator[T]) Enumerator[T] {
+		return loop.Take(n)
+	}
+}
+
+// SkipOp creates an Op which does Skip with n.
+func SkipOp[T any](n int) Op[T, T] {
+	return func(loop Enumerator[T]) Enumerator[T] {
+		return loop.Skip(n)
+	}
+}
@@ -0,0 +1,95 @@
+package linq
+
+import (
+	. "fmt"
+	"strings"
+)
+
+func ExampleApply() {
+	x := Apply(Range(1, 3), func(loop Enumerator[int]) Enumerator[string] {
+		return Select(func(i int) string { return Sprint(i) }, loop)
+	})
+	Printf("%q\n", x.ToSlice())
+	// Output:
+	// ["1" "2" "3"]
+}
+
+func ExampleApply2() {
+	x := Apply2(From([]string{"x", "yy", "zzz"}),
+		func(loop Enumerator[string]) Enumerator[int] {
+			return Select(func(s string) int { return len(s) }, loop)
+		},
+		Enumerator[int].Reverse)
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [3 2 1]
+}
+
+func ExampleApply3() {
+	x := Apply3(IntsFrom(1),
+		func(loop Enumerator[int]) Enumerator[int] {
+			return loop.Where(func(i int) bool { return i%2 == 1 })
+		},
+		func(loop Enumerator[int]) Enumerator[int] { return loop.Take(4) },
+		func(loop Enumerator[int]) Enumerator[string] {
+			return Select(func(i int) string {
+				return strings.Repeat("*", i)
+			}, loop)
+		})
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [* *** ***** *******]
+}
+
+func ExampleApply4() {
+	x := Apply4(FromString("hello"),
+		func(loop Enumerator[rune]) Enumerator[rune] { return loop.Skip(1) },
+		func(loop Enumerator[rune]) Enumerator[string] {
+			return Select(func(c rune) string { return string(c) }, loop)
+		},
+		func(loop Enumerator[string]) Enumerator[string] {
+			return Select(strings.ToUpper, loop)
+		},
+		Enumerator[string].Reverse)
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [O L L E]
+}
+
+func ExampleCompose() {
+	squares := MapOp(func(i int) int { return i * i })
+	show := MapOp(func(i int) string { return Sprintf("<%d>", i) })
+	op := Compose(Compose(FilterOp(func(i int) bool { return i%2 == 0 }),
+		squares), show)
+
+	Printf("%v\n", op(Range(1, 6)).ToSlice())
+	Printf("%v\n", op(From([]int{10, 11})).ToSlice())
+	// Output:
+	// [<4> <16> <36>]
+	// [<100>]
+}
+
+func ExamplePipe() {
+	firstEvens := []Op[int, int]{
+		FilterOp(func(i int) bool { return i%2 == 0 }),
+		TakeOp[int](3),
+	}
+	x := Pipe(IntsFrom(1), firstEvens...)
+	Printf("%v\n", x.ToSlice())
+
+	y := Pipe(Range(1, 10), SkipOp[int](7), Enumerator[int].Reverse)
+	Printf("%v\n", y.ToSlice())
+	// Output:
+	// [2 4 6]
+	// [10 9 8]
+}
+
+func ExampleFlatMapOp() {
+	op := FlatMapOp(func(s string) Enumerator[rune] { return FromString(s) })
+	x := Apply2(From([]string{"ab", "cde"}), op, MapOp(func(c rune) string {
+		return string(c)
+	}))
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [a b c d e]
+}
@@ -0,0 +1,57 @@
+package linq
+
+// Pivot reshapes loop into a table: it groups the elements by rowKey and
+// colKey and aggregates the values which valueSelector extracts from each
+// group, e.g. the total sales by month and region.
+// The result maps each row key to a row, which maps each column key to the
+// aggregate.
+// Cells without elements are absent from the rows.
+func Pivot[T any, R comparable, C comparable, V any, A any](
+	rowKey func(T) R, colKey func(T) C, valueSelector func(T) V,
+	aggregate func(Enumerator[V]) A, loop Enumerator[T]) map[R]map[C]A {
+	type cell struct {
+		row R
+		col C
+	}
+	result := make(map[R]map[C]A)
+	GroupByElement(func(element T) cell {
+		return cell{rowKey(element), colKey(element)}
+	}, valueSelector, loop)(func(g Grouping[cell, V]) {
+		row, ok := result[g.Key.row]
+		if !ok {
+			row = make(map[C]A)
+			result[g.Key.row] = row
+		}
+		row[g.Key.col] = aggregate(From(g.Elements))
+	})
+	return result
+}
+
+// Field represents a column of wide records for Unpivot.
+type Field[T any, V any] struct {
+	Name string
+	Get  func(T) V
+}
+
+// Cell represents a value of a table with its row key and column name.
+type Cell[K any, V any] struct {
+	Row    K
+	Column string
+	Value  V
+}
+
+// Unpivot is the inverse of Pivot: it creates an Enumerator which yields
+// a Cell for each of fields of each record of loop, e.g. turning a record
+// of monthly sales into one cell per month.
+// The cells of each record are yielded in the order of fields.
+func Unpivot[T any, K any, V any](rowKey func(T) K, fields []Field[T, V],
+	loop Enumerator[T]) Enumerator[Cell[K, V]] {
+	return func(yield func(Cell[K, V])) {
+		loop(func(record T) {
+			k := rowKey(record)
+			for _, f := range fields {
+				yield(Cell[K, V]{k, f.Name, f.Get(record)})
+			}
+		})
+	}
+}
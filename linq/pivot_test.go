@@ -0,0 +1,53 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+type sale struct {
+	Month  string
+	Region string
+	Amount int
+}
+
+var sales = []sale{
+	{"Jan", "East", 100}, {"Jan", "West", 80}, {"Jan", "East", 20},
+	{"Feb", "East", 50}, {"Feb", "West", 70}, {"Feb", "West", 30},
+	{"Mar", "West", 10},
+}
+
+func ExamplePivot() {
+	table := Pivot(
+		func(s sale) string { return s.Month },
+		func(s sale) string { return s.Region },
+		func(s sale) int { return s.Amount },
+		Sum[int], From(sales))
+	for _, month := range []string{"Jan", "Feb", "Mar"} {
+		Println(month, table[month])
+	}
+	// Output:
+	// Jan map[East:120 West:80]
+	// Feb map[East:50 West:100]
+	// Mar map[West:10]
+}
+
+func ExampleUnpivot() {
+	type quarter struct {
+		Region   string
+		Jan, Feb int
+	}
+	wide := From([]quarter{{"East", 120, 50}, {"West", 80, 100}})
+	cells := Unpivot(func(q quarter) string { return q.Region },
+		[]Field[quarter, int]{
+			{"Jan", func(q quarter) int { return q.Jan }},
+			{"Feb", func(q quarter) int { return q.Feb }},
+		}, wide)
+	cells.ForEach(func(c Cell[string, int]) {
+		Println(c.Row, c.Column, c.Value)
+	})
+	// Output:
+	// East Jan 120
+	// East Feb 50
+	// West Jan 80
+	// West Feb 100
+}
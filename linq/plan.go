@@ -0,0 +1,75 @@
+package linq
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Plan is an Enumerator which records how it was built, so that Explain
+// can describe the chain of operators, e.g.
+// "From(slice) → Where → Select → Take(3)".
+// It has all the methods of Enumerator; those redefined on Plan below
+// record themselves.
+type Plan[T any] struct {
+	Enumerator[T]
+	steps []string
+}
+
+// NewPlan creates a Plan from loop, which description describes.
+func NewPlan[T any](description string, loop Enumerator[T]) Plan[T] {
+	return Plan[T]{loop, []string{description}}
+}
+
+// then returns the steps of p followed by step.
+func (p Plan[T]) then(step string) []string {
+	steps := make([]string, len(p.steps), len(p.steps)+1)
+	copy(steps, p.steps)
+	return append(steps, step)
+}
+
+// Explain returns the description of the chain of operators of p.
+func (p Plan[T]) Explain() string {
+	return strings.Join(p.steps, " → ")
+}
+
+// Then applies op to p and records it as name.
+func (p Plan[T]) Then(name string, op Op[T, T]) Plan[T] {
+	return PlanApply(name, op, p)
+}
+
+// Where is the same as Enumerator.Where except that it returns a Plan.
+func (p Plan[T]) Where(predicate func(T) bool) Plan[T] {
+	return Plan[T]{p.Enumerator.Where(predicate), p.then("Where")}
+}
+
+// Take is the same as Enumerator.Take except that it returns a Plan.
+func (p Plan[T]) Take(n int) Plan[T] {
+	return Plan[T]{p.Enumerator.Take(n), p.then(fmt.Sprintf("Take(%d)", n))}
+}
+
+// TakeWhile is the same as Enumerator.TakeWhile except that it returns
+// a Plan.
+func (p Plan[T]) TakeWhile(predicate func(T) bool) Plan[T] {
+	return Plan[T]{p.Enumerator.TakeWhile(predicate), p.then("TakeWhile")}
+}
+
+// Skip is the same as Enumerator.Skip except that it returns a Plan.
+func (p Plan[T]) Skip(n int) Plan[T] {
+	return Plan[T]{p.Enumerator.Skip(n), p.then(fmt.Sprintf("Skip(%d)", n))}
+}
+
+// SkipWhile is the same as Enumerator.SkipWhile except that it returns
+// a Plan.
+func (p Plan[T]) SkipWhile(predicate func(T) bool) Plan[T] {
+	return Plan[T]{p.Enumerator.SkipWhile(predicate), p.then("SkipWhile")}
+}
+
+// PlanSelect is the same as Select except that it takes and returns Plans.
+func PlanSelect[T any, R any](f func(T) R, p Plan[T]) Plan[R] {
+	return Plan[R]{Select(f, p.Enumerator), p.then("Select")}
+}
+
+// PlanApply applies op to p and records it as name.
+func PlanApply[T any, R any](name string, op Op[T, R], p Plan[T]) Plan[R] {
+	return Plan[R]{op(p.Enumerator), p.then(name)}
+}
@@ -0,0 +1,40 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+func ExamplePlan_Explain() {
+	p := NewPlan("From(slice)", From([]int{5, 1, 4, 2, 3, 6, 8}))
+	q := PlanSelect(func(i int) int { return i * 10 },
+		p.Where(func(i int) bool { return i%2 == 0 })).Take(3)
+	Println(q.Explain())
+	Printf("%v\n", q.ToSlice())
+	// Output:
+	// From(slice) → Where → Select → Take(3)
+	// [40 20 60]
+}
+
+func ExamplePlan_Then() {
+	p := NewPlan("IntsFrom(1)", IntsFrom(1)).Skip(2).
+		Then("EveryNth(3)", func(loop Enumerator[int]) Enumerator[int] {
+			return loop.EveryNth(3)
+		}).TakeWhile(func(i int) bool { return i < 20 })
+	Println(p.Explain())
+	Printf("%v\n", p.ToSlice())
+	// Output:
+	// IntsFrom(1) → Skip(2) → EveryNth(3) → TakeWhile
+	// [3 6 9 12 15 18]
+}
+
+func ExamplePlanApply() {
+	p := NewPlan(`FromString("abc")`, FromString("abc"))
+	q := PlanApply("MapOp(string)", MapOp(func(c rune) string {
+		return string(c) + string(c)
+	}), p.SkipWhile(func(c rune) bool { return c == 'a' }))
+	Println(q.Explain())
+	Printf("%v\n", q.ToSlice())
+	// Output:
+	// FromString("abc") → SkipWhile → MapOp(string)
+	// [bb cc]
+}
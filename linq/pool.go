@@ -0,0 +1,121 @@
+package linq
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Reverse, Shuffle, OrderBy and TakeLast buffer elements temporarily each
+// time they are enumerated.
+// The buffers are recycled through a sync.Pool for each element type so
+// that repeated pipelines do not churn the garbage collector.
+
+// maxPooledBuffer is the largest capacity of buffers to be recycled.
+// Larger buffers are left to the garbage collector not to pin memory.
+const maxPooledBuffer = 1 << 16
+
+var (
+	bufferPools     sync.Map // (*T)(nil) -> *sync.Pool of *[]T
+	poolingDisabled int32
+)
+
+// SetBufferPooling enables or disables recycling of the temporary buffers.
+// It is enabled by default.
+// Disabling it may help to analyze memory profiles.
+func SetBufferPooling(enabled bool) {
+	var v int32
+	if !enabled {
+		v = 1
+	}
+	atomic.StoreInt32(&poolingDisabled, v)
+}
+
+// bufferPool returns the pool of buffers for T.
+func bufferPool[T any]() *sync.Pool {
+	key := any((*T)(nil)) // distinct for each T
+	if p, ok := bufferPools.Load(key); ok {
+		return p.(*sync.Pool)
+	}
+	p, _ := bufferPools.LoadOrStore(key, &sync.Pool{
+		New: func() any { return new([]T) },
+	})
+	return p.(*sync.Pool)
+}
+
+// getBuffer returns an empty buffer, which may be recycled.
+func getBuffer[T any]() *[]T {
+	if atomic.LoadInt32(&poolingDisabled) != 0 {
+		return new([]T)
+	}
+	return bufferPool[T]().Get().(*[]T)
+}
+
+// putBuffer clears the buffer and recycles it.
+func putBuffer[T any](buf *[]T) {
+	x := *buf
+	if atomic.LoadInt32(&poolingDisabled) != 0 || cap(x) > maxPooledBuffer {
+		return
+	}
+	var zero T
+	for i := range x {
+		x[i] = zero // Do not keep the elements alive.
+	}
+	*buf = x[:0]
+	bufferPool[T]().Put(buf)
+}
+
+// buffered calls f with a buffer holding all the elements of loop.
+// The buffer is recycled after f returns or panics.
+func buffered[T any](loop Enumerator[T], f func([]T)) {
+	buf := getBuffer[T]()
+	defer putBuffer(buf)
+	x := *buf
+	loop(func(element T) {
+		x = append(x, element)
+	})
+	*buf = x
+	f(x)
+}
+
+// OrderBy creates an Enumerator which yields the elements of the sequence
+// in ascending order according to less.
+// The sort is stable.
+// It buffers all the elements each time it is enumerated.
+func (loop Enumerator[T]) OrderBy(less func(a, b T) bool) Enumerator[T] {
+	return func(yield func(T)) {
+		buffered(loop, func(x []T) {
+			sort.Stable(sorter[T]{x, less})
+			for _, element := range x {
+				yield(element)
+			}
+		})
+	}
+}
+
+// TakeLast creates an Enumerator which yields the last n elements of the
+// sequence.
+// It buffers at most n elements each time it is enumerated.
+func (loop Enumerator[T]) TakeLast(n int) Enumerator[T] {
+	return func(yield func(T)) {
+		if n <= 0 {
+			return
+		}
+		buf := getBuffer[T]()
+		defer putBuffer(buf)
+		x := *buf
+		i := 0 // the index of the oldest element if len(x) == n
+		loop(func(element T) {
+			if len(x) < n {
+				x = append(x, element)
+			} else {
+				x[i] = element
+				i = (i + 1) % n
+			}
+		})
+		*buf = x
+		for j := range x {
+			yield(x[(i+j)%len(x)])
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package linq
+
+import (
+	. "fmt"
+	"testing"
+)
+
+func ExampleEnumerator_OrderBy() {
+	words := From([]string{"pear", "fig", "apple", "kiwi", "banana"})
+	x := words.OrderBy(func(a, b string) bool { return len(a) < len(b) })
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [fig pear kiwi apple banana]
+}
+
+func ExampleEnumerator_TakeLast() {
+	Printf("%v\n", Range(1, 10).TakeLast(3).ToSlice())
+	Printf("%v\n", Range(1, 2).TakeLast(3).ToSlice())
+	// Output:
+	// [8 9 10]
+	// [1 2]
+}
+
+func ExampleSetBufferPooling() {
+	SetBufferPooling(false)
+	defer SetBufferPooling(true)
+	Printf("%v\n", Range(1, 5).Reverse().ToSlice())
+	// Output:
+	// [5 4 3 2 1]
+}
+
+// With 1000 ints, Reverse allocates 15 times (25 KB) per enumeration
+// without pooling and 2 times (48 B) with pooling; OrderBy is similar.
+func BenchmarkEnumerator_Reverse(b *testing.B) {
+	loop := Range(0, 1000).Reverse()
+	for _, pooling := range []bool{true, false} {
+		b.Run(Sprint("pooling=", pooling), func(b *testing.B) {
+			SetBufferPooling(pooling)
+			defer SetBufferPooling(true)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				loop(func(int) {})
+			}
+		})
+	}
+}
+
+func BenchmarkEnumerator_OrderBy(b *testing.B) {
+	loop := Range(0, 1000).OrderBy(func(a, b int) bool { return a > b })
+	for _, pooling := range []bool{true, false} {
+		b.Run(Sprint("pooling=", pooling), func(b *testing.B) {
+			SetBufferPooling(pooling)
+			defer SetBufferPooling(true)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				loop(func(int) {})
+			}
+		})
+	}
+}
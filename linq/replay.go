@@ -0,0 +1,159 @@
+package linq
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrEnumeratedTwice is the error with which an Enumerator created by
+// OneShot panics when it is enumerated again.
+var ErrEnumeratedTwice = errors.New("linq: one-shot sequence enumerated twice")
+
+// OneShot creates an Enumerator which can be enumerated only once.
+// It panics with ErrEnumeratedTwice if it is enumerated again, instead of
+// silently yielding nothing as FromChan or FromReader would.
+func (loop Enumerator[T]) OneShot() Enumerator[T] {
+	var used int32
+	return func(yield func(T)) {
+		if !atomic.CompareAndSwapInt32(&used, 0, 1) {
+			panic(ErrEnumeratedTwice)
+		}
+		loop(yield)
+	}
+}
+
+// tryNext calls next and returns the value with which it panicked, if any,
+// instead of panicking.
+func tryNext[T any](next func() (T, bool)) (element T, ok bool, failure any) {
+	defer func() {
+		failure = recover()
+	}()
+	element, ok = next()
+	return
+}
+
+// Replayable creates an Enumerator which enumerates the sequence only once
+// and buffers its elements, so that every enumeration yields the same
+// elements.
+// If the sequence panics, every enumeration, not only the one which
+// demanded the failing element, yields the buffered elements and then
+// panics with the same value, so that a truncated sequence never looks
+// complete.
+// The sequence is enumerated lazily in another goroutine as far as the
+// enumerations demand; if it never runs out, the goroutine remains.
+// It is safe for concurrent enumerations.
+func (loop Enumerator[T]) Replayable() Enumerator[T] {
+	var mu sync.Mutex
+	var buf []T
+	var next func() (T, bool)
+	done := false
+	var failure any // the value with which loop panicked
+	// get returns the i-th element, pulling it from loop if needed.
+	get := func(i int) (element T, ok bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		if i == len(buf) && !done {
+			if next == nil {
+				next, _ = pull(loop)
+			}
+			element, ok, failure = tryNext(next)
+			if ok {
+				buf = append(buf, element)
+			} else {
+				done = true
+			}
+		}
+		if i < len(buf) {
+			return buf[i], true
+		}
+		if failure != nil {
+			panic(failure)
+		}
+		return element, false
+	}
+	return func(yield func(T)) {
+		for i := 0; ; i++ {
+			element, ok := get(i)
+			if !ok {
+				return
+			}
+			yield(element)
+		}
+	}
+}
+
+// Tee creates n Enumerators, each of which yields the elements of the
+// sequence, enumerating the sequence only once.
+// The elements are buffered only while some of the Enumerators have not
+// yielded them yet; thus the buffer holds the gap between the fastest and
+// the slowest Enumerator.
+// Each of the Enumerators resumes from where it left off when it is
+// enumerated again.
+// The sequence is enumerated lazily in another goroutine; if it never runs
+// out, the goroutine remains.
+// If the sequence panics, each of the Enumerators panics with the same
+// value when it reaches the failing element.
+// They are safe for concurrent enumerations.
+func (loop Enumerator[T]) Tee(n int) []Enumerator[T] {
+	var mu sync.Mutex
+	var buf []T // the elements from the base-th
+	base := 0
+	pos := make([]int, n) // the index of the next element of each
+	var next func() (T, bool)
+	done := false
+	var failure any // the value with which loop panicked
+	// get returns the next element of the i-th Enumerator.
+	get := func(i int) (element T, ok bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		p := pos[i]
+		if p-base == len(buf) {
+			if !done {
+				if next == nil {
+					next, _ = pull(loop)
+				}
+				element, ok, failure = tryNext(next)
+				done = !ok
+			}
+			if done {
+				if failure != nil {
+					panic(failure)
+				}
+				return element, false
+			}
+			buf = append(buf, element)
+		}
+		element = buf[p-base]
+		pos[i]++
+		min := pos[0]
+		for _, q := range pos[1:] {
+			if q < min {
+				min = q
+			}
+		}
+		if min > base {
+			var zero T
+			for j := 0; j < min-base; j++ {
+				buf[j] = zero // for the garbage collection
+			}
+			buf = buf[min-base:]
+			base = min
+		}
+		return element, true
+	}
+	result := make([]Enumerator[T], n)
+	for i := range result {
+		i := i
+		result[i] = func(yield func(T)) {
+			for {
+				element, ok := get(i)
+				if !ok {
+					return
+				}
+				yield(element)
+			}
+		}
+	}
+	return result
+}
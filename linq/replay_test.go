@@ -0,0 +1,104 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+func ExampleEnumerator_OneShot() {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	loop := FromChan((<-chan int)(ch)).OneShot()
+	Printf("%v\n", loop.ToSlice())
+
+	defer func() {
+		Println(recover())
+	}()
+	loop.ToSlice()
+	// Output:
+	// [1 2]
+	// linq: one-shot sequence enumerated twice
+}
+
+func ExampleEnumerator_Replayable() {
+	ch := make(chan string)
+	go func() {
+		for _, s := range []string{"Funa", "1-hachi", "2-hachi"} {
+			ch <- s
+		}
+		close(ch)
+	}()
+	loop := FromChan((<-chan string)(ch)).Replayable()
+	Printf("%v\n", loop.Take(1).ToSlice())
+	Printf("%v\n", loop.ToSlice())
+	Printf("%v\n", loop.ToSlice())
+	// Output:
+	// [Funa]
+	// [Funa 1-hachi 2-hachi]
+	// [Funa 1-hachi 2-hachi]
+}
+
+func ExampleEnumerator_Replayable_failure() {
+	loop := Enumerator[int](func(yield func(int)) {
+		yield(1)
+		yield(2)
+		panic("broken")
+	}).Replayable()
+	// Each enumeration panics after the buffered elements.
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				Println(recover())
+			}()
+			loop.ForEach(func(i int) {
+				Println(i)
+			})
+		}()
+	}
+	// Output:
+	// 1
+	// 2
+	// broken
+	// 1
+	// 2
+	// broken
+}
+
+func ExampleEnumerator_Tee() {
+	ch := make(chan int)
+	go func() {
+		for i := 1; i <= 6; i++ {
+			ch <- i
+		}
+		close(ch)
+	}()
+	branches := FromChan((<-chan int)(ch)).Tee(2)
+	evens := branches[0].Where(func(i int) bool { return i%2 == 0 })
+	Printf("%v\n", evens.Take(1).ToSlice())
+	Printf("%v\n", Sum(branches[1]))
+	Printf("%v\n", evens.ToSlice())
+	// Output:
+	// [2]
+	// 21
+	// [4 6]
+}
+
+func ExampleEnumerator_Tee_failure() {
+	branches := Enumerator[int](func(yield func(int)) {
+		yield(1)
+		panic("broken")
+	}).Tee(2)
+	// Each branch panics when it reaches the failure.
+	for _, b := range branches {
+		func() {
+			defer func() {
+				Println(recover())
+			}()
+			Printf("%v\n", b.ToSlice())
+		}()
+	}
+	// Output:
+	// broken
+	// broken
+}
@@ -0,0 +1,139 @@
+package linq
+
+// Seq represents a sequence which supports early exit without panics.
+// Its yield function returns false to request the sequence to stop, and the
+// sequence must not call yield any more then.
+// It has the same shape as iter.Seq of Go 1.23.
+//
+// Enumerator calls LoopWithExit to stop, which panics and recovers once per
+// enumeration.
+// That costs a few hundred nanoseconds and dominates short-circuiting
+// operators like Take and First on short sequences in tight loops.
+// Seq avoids the cost when both the source and the operators are Seq.
+// It is an opt-in alternative: the operators of Enumerator still stop by
+// LoopWithExit, since the yield of Enumerator cannot ask its source to
+// stop, and converting an Enumerator by its Seq method keeps the cost.
+type Seq[T any] func(yield func(element T) bool)
+
+// SeqFrom creates a Seq from a slice.
+func SeqFrom[T ~[]E, E any](x T) Seq[E] {
+	return func(yield func(E) bool) {
+		for _, element := range x {
+			if !yield(element) {
+				return
+			}
+		}
+	}
+}
+
+// SeqRange creates a Seq of count integers from start.
+func SeqRange(start, count int) Seq[int] {
+	return func(yield func(int) bool) {
+		end := start + count
+		for i := start; i < end; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Seq converts loop to a Seq.
+// The result stops loop by LoopWithExit, i.e. by a panic, when its yield
+// returns false.
+func (loop Enumerator[T]) Seq() Seq[T] {
+	return func(yield func(T) bool) {
+		loop.LoopWithExit(func(element T, exit func()) {
+			if !yield(element) {
+				exit()
+			}
+		})
+	}
+}
+
+// Enumerator converts s to an Enumerator.
+func (s Seq[T]) Enumerator() Enumerator[T] {
+	return func(yield func(T)) {
+		s(func(element T) bool {
+			yield(element)
+			return true
+		})
+	}
+}
+
+// Where creates a Seq which selects elements by applying predicate to each
+// of them.
+func (s Seq[T]) Where(predicate func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		s(func(element T) bool {
+			return !predicate(element) || yield(element)
+		})
+	}
+}
+
+// Take creates a Seq which yields the first n elements.
+// It stops s after the n-th element.
+func (s Seq[T]) Take(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		s(func(element T) bool {
+			i++
+			return yield(element) && i < n
+		})
+	}
+}
+
+// TakeWhile creates a Seq which takes elements until predicate applied to
+// the element results in false.
+func (s Seq[T]) TakeWhile(predicate func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		s(func(element T) bool {
+			return predicate(element) && yield(element)
+		})
+	}
+}
+
+// Any reports whether some element satisfies predicate.
+// It stops s at the element.
+func (s Seq[T]) Any(predicate func(T) bool) bool {
+	result := false
+	s(func(element T) bool {
+		result = predicate(element)
+		return !result
+	})
+	return result
+}
+
+// All reports whether every element satisfies predicate.
+// It stops s at the first element which does not.
+func (s Seq[T]) All(predicate func(T) bool) bool {
+	result := true
+	s(func(element T) bool {
+		result = predicate(element)
+		return result
+	})
+	return result
+}
+
+// First returns the first element, or an absent Optional if s is empty.
+func (s Seq[T]) First() Optional[T] {
+	var result Optional[T]
+	s(func(element T) bool {
+		result = Some(element)
+		return false
+	})
+	return result
+}
+
+// ToSlice creates a slice from s.
+func (s Seq[T]) ToSlice() []T {
+	result := []T{}
+	s(func(element T) bool {
+		result = append(result, element)
+		return true
+	})
+	return result
+}
@@ -0,0 +1,76 @@
+package linq
+
+import (
+	. "fmt"
+	"testing"
+)
+
+func ExampleSeq() {
+	s := SeqRange(1, 100).Where(func(i int) bool { return i%3 == 0 })
+	Printf("%v\n", s.Take(4).ToSlice())
+	Println(s.Any(func(i int) bool { return i > 50 }))
+	Println(s.All(func(i int) bool { return i < 50 }))
+	Println(s.TakeWhile(func(i int) bool { return i < 10 }).First())
+	// Output:
+	// [3 6 9 12]
+	// true
+	// false
+	// Some(3)
+}
+
+func ExampleEnumerator_Seq() {
+	s := IntsFrom(1).Seq().Take(3)
+	Printf("%v\n", s.Enumerator().ToSlice())
+	Printf("%v\n", SeqFrom([]string{"a", "b"}).Enumerator().ToSlice())
+	// Output:
+	// [1 2 3]
+	// [a b]
+}
+
+// The same operators stop by a panic on Enumerator and by returning false
+// on Seq; BenchmarkEnumerator_Seq shows that the conversion keeps the cost.
+//
+//	BenchmarkEnumerator_Take     552 ns/op    120 B/op    5 allocs/op
+//	BenchmarkSeq_Take              3 ns/op      0 B/op    0 allocs/op
+//	BenchmarkEnumerator_First    583 ns/op    104 B/op    5 allocs/op
+//	BenchmarkSeq_First             1 ns/op      0 B/op    0 allocs/op
+//	BenchmarkEnumerator_Seq      588 ns/op    128 B/op    6 allocs/op
+func BenchmarkEnumerator_Take(b *testing.B) {
+	loop := Range(0, 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		loop.Take(3)(func(int) {})
+	}
+}
+
+func BenchmarkSeq_Take(b *testing.B) {
+	s := SeqRange(0, 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Take(3)(func(int) bool { return true })
+	}
+}
+
+func BenchmarkEnumerator_First(b *testing.B) {
+	loop := Range(0, 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		loop.First()
+	}
+}
+
+func BenchmarkSeq_First(b *testing.B) {
+	s := SeqRange(0, 10)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.First()
+	}
+}
+
+func BenchmarkEnumerator_Seq(b *testing.B) {
+	s := Range(0, 10).Seq()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.First()
+	}
+}
@@ -0,0 +1,235 @@
+package linq
+
+import "container/list"
+
+// DistinctWithin creates an Enumerator which removes duplicate elements
+// from loop, remembering at most capacity recently seen elements.
+// An element that has been forgotten may be yielded again.
+// The space complexity is O(capacity) even if loop is infinite.
+func DistinctWithin[T comparable](capacity int,
+	loop Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		recent := list.New() // the most recently seen element at the front
+		seen := make(map[T]*list.Element)
+		loop(func(element T) {
+			if e, ok := seen[element]; ok {
+				recent.MoveToFront(e)
+				return
+			}
+			if capacity > 0 {
+				if recent.Len() >= capacity {
+					oldest := recent.Back()
+					delete(seen, recent.Remove(oldest).(T))
+				}
+				seen[element] = recent.PushFront(element)
+			}
+			yield(element)
+		})
+	}
+}
+
+// SortedDistinct creates an Enumerator which removes duplicate elements
+// from loop, assuming that equal elements are adjacent, e.g. loop is
+// sorted.
+// It compares each element only with its predecessor and runs in O(1)
+// space.
+func SortedDistinct[T comparable](loop Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		var prev T
+		first := true
+		loop(func(element T) {
+			if first || element != prev {
+				yield(element)
+				prev, first = element, false
+			}
+		})
+	}
+}
+
+// Except creates an Enumerator which yields the distinct elements of first
+// that do not appear in second.
+// second is enumerated completely before the first element is yielded.
+func Except[T comparable](first, second Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		seen := make(map[T]bool)
+		second(func(element T) {
+			seen[element] = true
+		})
+		first(func(element T) {
+			if !seen[element] {
+				seen[element] = true
+				yield(element)
+			}
+		})
+	}
+}
+
+// Intersect creates an Enumerator which yields the distinct elements of
+// first that also appear in second.
+// second is enumerated completely before the first element is yielded.
+func Intersect[T comparable](first, second Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		candidates := make(map[T]bool)
+		second(func(element T) {
+			candidates[element] = true
+		})
+		first(func(element T) {
+			if candidates[element] {
+				delete(candidates, element)
+				yield(element)
+			}
+		})
+	}
+}
+
+// ExceptFunc is a variant of Except for elements which are not comparable.
+// Elements x and y are regarded as the same if equal(x, y) returns true,
+// in which case hash(x) must be equal to hash(y).
+func ExceptFunc[T any](hash func(T) uint64, equal func(T, T) bool,
+	first, second Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		seen := newHashSet(hash, equal)
+		second(func(element T) {
+			seen.add(element)
+		})
+		first(func(element T) {
+			if seen.add(element) {
+				yield(element)
+			}
+		})
+	}
+}
+
+// IntersectFunc is a variant of Intersect for elements which are not
+// comparable.
+// Elements x and y are regarded as the same if equal(x, y) returns true,
+// in which case hash(x) must be equal to hash(y).
+func IntersectFunc[T any](hash func(T) uint64, equal func(T, T) bool,
+	first, second Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		candidates := newHashSet(hash, equal)
+		second(func(element T) {
+			candidates.add(element)
+		})
+		yielded := newHashSet(hash, equal)
+		first(func(element T) {
+			if candidates.contains(element) && yielded.add(element) {
+				yield(element)
+			}
+		})
+	}
+}
+
+// SymmetricDifference creates an Enumerator which yields the distinct
+// elements which appear in exactly one of first and second: those of
+// first and then those of second, each in its own order.
+// second is enumerated completely and buffered before the first element is
+// yielded.
+func SymmetricDifference[T comparable](first,
+	second Enumerator[T]) Enumerator[T] {
+	return SymmetricDifferenceBy(func(e T) T { return e }, first, second)
+}
+
+// SymmetricDifferenceBy is a variant of SymmetricDifference which compares
+// the elements by the keys which keySelector returns.
+// For each key, only the first element which has it is yielded.
+func SymmetricDifferenceBy[T any, K comparable](keySelector func(T) K,
+	first, second Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		var others []T // the distinct elements of second
+		inSecond := make(map[K]bool)
+		second(func(element T) {
+			k := keySelector(element)
+			if !inSecond[k] {
+				inSecond[k] = true
+				others = append(others, element)
+			}
+		})
+		inFirst := make(map[K]bool)
+		first(func(element T) {
+			k := keySelector(element)
+			if !inFirst[k] {
+				inFirst[k] = true
+				if !inSecond[k] {
+					yield(element)
+				}
+			}
+		})
+		for _, element := range others {
+			if !inFirst[keySelector(element)] {
+				yield(element)
+			}
+		}
+	}
+}
+
+// hashSet is a set of elements with user-defined hash and equality.
+// It uses open addressing with linear probing, keeping the elements in a
+// single slice so that it allocates only when it grows.
+type hashSet[T any] struct {
+	slots []hashSlot[T] // The length is 0 or a power of 2.
+	count int
+	hash  func(T) uint64
+	equal func(T, T) bool
+}
+
+type hashSlot[T any] struct {
+	used  bool
+	hash  uint64
+	value T
+}
+
+func newHashSet[T any](hash func(T) uint64,
+	equal func(T, T) bool) *hashSet[T] {
+	return &hashSet[T]{hash: hash, equal: equal}
+}
+
+// find returns the index of the slot which holds x or where x should be
+// put.
+func (s *hashSet[T]) find(h uint64, x T) int {
+	mask := uint64(len(s.slots) - 1)
+	for i := h & mask; ; i = (i + 1) & mask {
+		slot := &s.slots[i]
+		if !slot.used || slot.hash == h && s.equal(slot.value, x) {
+			return int(i)
+		}
+	}
+}
+
+// contains reports whether s has x.
+func (s *hashSet[T]) contains(x T) bool {
+	if s.count == 0 {
+		return false
+	}
+	return s.slots[s.find(s.hash(x), x)].used
+}
+
+// add puts x into s and reports whether x was absent before.
+func (s *hashSet[T]) add(x T) bool {
+	if (s.count+1)*4 > len(s.slots)*3 {
+		s.grow()
+	}
+	h := s.hash(x)
+	i := s.find(h, x)
+	if s.slots[i].used {
+		return false
+	}
+	s.slots[i] = hashSlot[T]{true, h, x}
+	s.count++
+	return true
+}
+
+// grow doubles the number of slots, keeping the load factor below 3/4.
+func (s *hashSet[T]) grow() {
+	old := s.slots
+	n := len(old) * 2
+	if n == 0 {
+		n = 8
+	}
+	s.slots = make([]hashSlot[T], n)
+	for _, slot := range old {
+		if slot.used {
+			s.slots[s.find(slot.hash, slot.value)] = slot
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+func ExampleDistinctWithin() {
+	seq := From([]int{1, 2, 1, 3, 1, 4, 2, 5, 2})
+	x := DistinctWithin(3, seq)
+	Printf("%v\n", x.ToSlice())
+
+	// An infinite sequence 0, 1, 2, 0, 1, 2, ... has only 3 distinct values.
+	cycle := Select(func(i int) int { return i % 3 }, IntsFrom(0))
+	y := DistinctWithin(3, cycle).Take(3)
+	Printf("%v\n", y.ToSlice())
+	// Output:
+	// [1 2 3 4 2 5]
+	// [0 1 2]
+}
+
+func ExampleSortedDistinct() {
+	x := SortedDistinct(From([]int{1, 1, 2, 3, 3, 3, 5, 1}))
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [1 2 3 5 1]
+}
+
+func ExampleExcept() {
+	x := Except(From([]int{1, 2, 2, 3, 4, 5}), From([]int{2, 4, 6}))
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [1 3 5]
+}
+
+func ExampleIntersect() {
+	x := Intersect(From([]int{1, 2, 2, 3, 4, 5}), From([]int{2, 4, 6}))
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [2 4]
+}
+
+func ExampleSymmetricDifference() {
+	x := SymmetricDifference(From([]int{1, 2, 2, 3, 4, 1}),
+		From([]int{6, 2, 4, 6, 7}))
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [1 3 6 7]
+}
+
+func ExampleSymmetricDifferenceBy() {
+	type entry struct {
+		key   string
+		value int
+	}
+	before := From([]entry{{"a", 1}, {"b", 2}, {"c", 3}})
+	after := From([]entry{{"b", 20}, {"d", 4}, {"c", 30}})
+	x := SymmetricDifferenceBy(func(e entry) string { return e.key },
+		before, after)
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [{a 1} {d 4}]
+}
+
+// hashInts is an FNV-1a hash of a slice of ints.
+func hashInts(x []int) uint64 {
+	h := uint64(14695981039346656037)
+	for _, i := range x {
+		h = (h ^ uint64(i)) * 1099511628211
+	}
+	return h
+}
+
+func equalInts(x, y []int) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if x[i] != y[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func ExampleExceptFunc() {
+	first := From([][]int{{1, 2}, {3}, {1, 2}, {}, {4, 5, 6}})
+	second := From([][]int{{3}, {4, 5}})
+	x := ExceptFunc(hashInts, equalInts, first, second)
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [[1 2] [] [4 5 6]]
+}
+
+func ExampleIntersectFunc() {
+	first := From([][]int{{1, 2}, {3}, {1, 2}, {}, {4, 5, 6}})
+	second := From([][]int{{1, 2}, {}, {4, 5}})
+	x := IntersectFunc(hashInts, equalInts, first, second)
+	Printf("%v\n", x.ToSlice())
+
+	// Many elements make the internal table grow.
+	y := IntersectFunc(func(i int) uint64 { return uint64(i % 7) },
+		func(i, j int) bool { return i == j },
+		Range(0, 1000), Range(500, 1000))
+	Println(y.Count())
+	// Output:
+	// [[1 2] []]
+	// 500
+}
@@ -0,0 +1,142 @@
+package linq
+
+import "math/rand"
+
+// Sized represents a sequence whose length is known in advance.
+// It has all the methods of Enumerator.
+// Some of the methods, e.g. ToSlice, Count and Reverse, use Len to allocate
+// memory exactly once or to avoid enumeration.
+// Len must be equal to the number of elements which Enumerator yields.
+//
+// A Sized created by FromSized is backed by the slice, and its Count,
+// ElementAt, Skip, Take, TakeLast, Reverse and ToSlice index the slice
+// directly instead of enumerating the sequence.
+// The elements of the slice must not be modified while the sequence is in
+// use.
+//
+// The length is carried through Skip, Take and TakeLast of Sized only:
+// the other methods of Enumerator, e.g. s.Where(f), and the functions such
+// as Select return a plain Enumerator, whose ToSlice grows its result as
+// usual.
+// Likewise From(x).ToSlice() does not allocate exactly once; use
+// FromSized(x) for that, and apply the length-aware methods to it
+// directly.
+type Sized[T any] struct {
+	Enumerator[T]
+	Len int
+
+	elements []T // nil unless backed by a slice
+}
+
+// FromSized creates a Sized backed by a slice.
+// The slice is not copied.
+func FromSized[T ~[]E, E any](x T) Sized[E] {
+	return Sized[E]{From(x), len(x), x}
+}
+
+// WithLen creates a Sized from loop which will yield n elements.
+// n less than 0 is treated as 0.
+func WithLen[T any](n int, loop Enumerator[T]) Sized[T] {
+	if n < 0 {
+		n = 0
+	}
+	return Sized[T]{Enumerator: loop, Len: n}
+}
+
+// Count returns Len without enumerating the sequence.
+// A negative Len is treated as 0.
+func (s Sized[T]) Count() int {
+	if s.Len < 0 {
+		return 0
+	}
+	return s.Len
+}
+
+// clamp returns n limited to the range from 0 to the length.
+func (s Sized[T]) clamp(n int) int {
+	if n < 0 {
+		return 0
+	} else if n > s.Count() {
+		return s.Count()
+	}
+	return n
+}
+
+// ElementAt returns the element at index, or an absent Optional if index
+// is out of range.
+// It takes O(1) time if the sequence is backed by a slice.
+func (s Sized[T]) ElementAt(index int) Optional[T] {
+	if index < 0 || index >= s.Len {
+		return None[T]()
+	}
+	if s.elements != nil {
+		return Some(s.elements[index])
+	}
+	return s.Enumerator.ElementAt(index)
+}
+
+// Skip creates a Sized which bypasses the first n elements.
+// It takes O(1) time if the sequence is backed by a slice.
+func (s Sized[T]) Skip(n int) Sized[T] {
+	n = s.clamp(n)
+	if s.elements != nil {
+		return FromSized(s.elements[n:])
+	}
+	return Sized[T]{Enumerator: s.Enumerator.Skip(n), Len: s.Count() - n}
+}
+
+// Take creates a Sized which yields the first n elements.
+// It takes O(1) time if the sequence is backed by a slice.
+func (s Sized[T]) Take(n int) Sized[T] {
+	n = s.clamp(n)
+	if s.elements != nil {
+		return FromSized(s.elements[:n:n])
+	}
+	return Sized[T]{Enumerator: s.Enumerator.Take(n), Len: n}
+}
+
+// TakeLast creates a Sized which yields the last n elements.
+// It takes O(1) time if the sequence is backed by a slice.
+func (s Sized[T]) TakeLast(n int) Sized[T] {
+	n = s.clamp(n)
+	if s.elements != nil {
+		return FromSized(s.elements[len(s.elements)-n:])
+	}
+	return Sized[T]{Enumerator: s.Enumerator.Skip(s.Count() - n), Len: n}
+}
+
+// ToSlice creates a slice from the sequence, allocating it exactly once.
+// A negative Len is treated as 0.
+func (s Sized[T]) ToSlice() []T {
+	if s.elements != nil {
+		return append(make([]T, 0, len(s.elements)), s.elements...)
+	}
+	result := make([]T, 0, s.Count())
+	s.Enumerator(func(element T) {
+		result = append(result, element)
+	})
+	return result
+}
+
+// Reverse creates an Enumerator which yields the elements of the sequence
+// in reverse order.
+// It allocates a buffer of Len elements each time it is enumerated, unless
+// the sequence is backed by a slice.
+func (s Sized[T]) Reverse() Enumerator[T] {
+	return func(yield func(T)) {
+		if s.elements != nil {
+			reverse(s.elements, yield)
+		} else {
+			reverse(s.ToSlice(), yield)
+		}
+	}
+}
+
+// Shuffle creates an Enumerator which yields the elements of the sequence
+// in random order chosen by r.
+// It allocates a buffer of Len elements each time it is enumerated.
+func (s Sized[T]) Shuffle(r *rand.Rand) Enumerator[T] {
+	return func(yield func(T)) {
+		shuffle(r, s.ToSlice(), yield)
+	}
+}
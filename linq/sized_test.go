@@ -0,0 +1,70 @@
+package linq
+
+import (
+	. "fmt"
+	"testing"
+)
+
+func ExampleFromSized() {
+	s := FromSized([]string{"a", "b", "c"})
+	Println(s.Count())
+	Printf("%v\n", s.Reverse().ToSlice())
+
+	// The methods of Enumerator are available.
+	Printf("%v\n", s.Where(func(x string) bool { return x != "b" }).ToSlice())
+	// Output:
+	// 3
+	// [c b a]
+	// [a c]
+}
+
+func ExampleFromSized_slice() {
+	// The methods index the slice directly.
+	s := FromSized([]int{1, 2, 3, 4, 5, 6})
+	Println(s.Count(), s.ElementAt(2), s.ElementAt(6))
+	Printf("%v\n", s.Skip(1).TakeLast(3).ToSlice())
+	Printf("%v\n", s.Take(2).Reverse().ToSlice())
+	// Output:
+	// 6 Some(3) None
+	// [4 5 6]
+	// [2 1]
+}
+
+func ExampleWithLen() {
+	s := WithLen(5, Range(1, 5))
+	x := s.ToSlice()
+	Println(x, len(x), cap(x))
+
+	// A negative length is treated as 0.
+	y := WithLen(-1, Range(1, 2)).ToSlice()
+	Println(y, Sized[int]{Enumerator: Range(1, 2), Len: -1}.Count())
+
+	// The length is carried through Skip, Take and TakeLast.
+	z := s.Skip(1).TakeLast(3)
+	Println(z.Count(), z.ElementAt(0), z.ToSlice())
+	// Output:
+	// [1 2 3 4 5] 5 5
+	// [1 2] 0
+	// 3 Some(3) [3 4 5]
+}
+
+func BenchmarkSized_ToSlice(b *testing.B) {
+	loop := WithLen(10000, Range(0, 10000))
+	for i := 0; i < b.N; i++ {
+		loop.ToSlice()
+	}
+}
+
+func BenchmarkSized_TakeLast(b *testing.B) {
+	s := FromSized(Range(0, 10000).ToSlice())
+	for i := 0; i < b.N; i++ {
+		s.TakeLast(10).ToSlice()
+	}
+}
+
+func BenchmarkEnumerator_TakeLast(b *testing.B) {
+	loop := From(Range(0, 10000).ToSlice())
+	for i := 0; i < b.N; i++ {
+		loop.TakeLast(10).ToSlice()
+	}
+}
@@ -0,0 +1,50 @@
+package linq
+
+import "sort"
+
+// sorter implements sort.Interface for a slice and a less function.
+type sorter[T any] struct {
+	x    []T
+	less func(a, b T) bool
+}
+
+func (s sorter[T]) Len() int           { return len(s.x) }
+func (s sorter[T]) Less(i, j int) bool { return s.less(s.x[i], s.x[j]) }
+func (s sorter[T]) Swap(i, j int)      { s.x[i], s.x[j] = s.x[j], s.x[i] }
+
+// keySorter implements sort.Interface for a slice and its keys.
+type keySorter[T any, K Ordered] struct {
+	x    []T
+	keys []K
+}
+
+func (s keySorter[T, K]) Len() int           { return len(s.x) }
+func (s keySorter[T, K]) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s keySorter[T, K]) Swap(i, j int) {
+	s.x[i], s.x[j] = s.x[j], s.x[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+// ToSortedSlice creates a slice from the sequence and sorts it according to
+// less.
+// The sort is stable.
+func (loop Enumerator[T]) ToSortedSlice(less func(a, b T) bool) []T {
+	result := loop.ToSlice()
+	sort.Stable(sorter[T]{result, less})
+	return result
+}
+
+// ToSortedSliceBy creates a slice from loop and sorts it by the keys which
+// keySelector extracts.
+// keySelector is called once for each element.
+// The sort is stable.
+func ToSortedSliceBy[T any, K Ordered](keySelector func(T) K,
+	loop Enumerator[T]) []T {
+	result := loop.ToSlice()
+	keys := make([]K, len(result))
+	for i, element := range result {
+		keys[i] = keySelector(element)
+	}
+	sort.Stable(keySorter[T, K]{result, keys})
+	return result
+}
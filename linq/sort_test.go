@@ -0,0 +1,23 @@
+package linq
+
+import (
+	. "fmt"
+	"strings"
+)
+
+func ExampleEnumerator_ToSortedSlice() {
+	x := From([]int{3, 1, 4, 1, 5, 9, 2, 6}).ToSortedSlice(func(a, b int) bool {
+		return a > b
+	})
+	Printf("%v\n", x)
+	// Output:
+	// [9 6 5 4 3 2 1 1]
+}
+
+func ExampleToSortedSliceBy() {
+	words := From(strings.Fields("the quick brown fox jumps over the lazy dog"))
+	x := ToSortedSliceBy(func(s string) int { return len(s) }, words)
+	Printf("%v\n", x)
+	// Output:
+	// [the fox the dog over lazy quick brown jumps]
+}
@@ -0,0 +1,142 @@
+package linq
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// FromRows creates an Enumerator which yields the result of scan for each
+// row of rows.
+// rows will be closed when the enumeration completes or terminates early,
+// so the enumerator can be enumerated only once.
+// The enumerator may panic with the error from scan or rows.Err().
+func FromRows[T any](scan func(*sql.Rows) (T, error),
+	rows *sql.Rows) Enumerator[T] {
+	return func(yield func(T)) {
+		defer rows.Close()
+		for rows.Next() {
+			element, err := scan(rows)
+			if err != nil {
+				panic(err)
+			}
+			yield(element)
+		}
+		if err := rows.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// FromRowsStruct creates an Enumerator which scans each row of rows into a
+// struct of type T.
+// A column is stored into the field whose db tag is the column name, e.g.
+// `db:"user_id"`, or otherwise into the field whose name equals the column
+// name ignoring case.
+// The fields of embedded structs are also mapped; the fields tagged with
+// `db:"-"` are not.
+// The columns with no such fields are discarded.
+// The mapping is computed once per type and cached.
+// Otherwise it behaves as FromRows.
+func FromRowsStruct[T any](rows *sql.Rows) Enumerator[T] {
+	return func(yield func(T)) {
+		defer rows.Close()
+		fields, err := rowsMapping(reflect.TypeOf((*T)(nil)).Elem(), rows)
+		if err != nil {
+			panic(err)
+		}
+		dest := make([]any, len(fields))
+		var discard any
+		for rows.Next() {
+			var element T
+			v := reflect.ValueOf(&element).Elem()
+			for i, index := range fields {
+				if index == nil {
+					dest[i] = &discard
+				} else {
+					dest[i] = v.FieldByIndex(index).Addr().Interface()
+				}
+			}
+			if err := rows.Scan(dest...); err != nil {
+				panic(err)
+			}
+			yield(element)
+		}
+		if err := rows.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// dbFields caches the map from column names to the indexes of fields for
+// each struct type; the names without db tags are in lower case.
+var dbFields sync.Map // reflect.Type -> map[string][]int
+
+// rowsMapping returns the indexes of the fields of t for the columns of
+// rows, or nil for the columns with no fields.
+func rowsMapping(t reflect.Type, rows *sql.Rows) ([][]int, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("linq: FromRowsStruct: %v is not a struct", t)
+	}
+	m, ok := dbFields.Load(t)
+	if !ok {
+		m, _ = dbFields.LoadOrStore(t, structFields(t))
+	}
+	byName := m.(map[string][]int)
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]int, len(columns))
+	for i, c := range columns {
+		if index, ok := byName[c]; ok {
+			result[i] = index
+		} else {
+			result[i] = byName[strings.ToLower(c)]
+		}
+	}
+	return result, nil
+}
+
+// structFields maps the column names to the indexes of the fields of t.
+// The tagged names take precedence over the untagged ones.
+func structFields(t reflect.Type) map[string][]int {
+	tagged := make(map[string][]int)
+	untagged := make(map[string][]int)
+	var walk func(t reflect.Type, prefix []int)
+	walk = func(t reflect.Type, prefix []int) {
+		var embedded [][]int // walked last so that the fields of t win
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("db")
+			if tag == "-" {
+				continue
+			}
+			index := append(append([]int(nil), prefix...), i)
+			if f.Anonymous && f.Type.Kind() == reflect.Struct && tag == "" {
+				embedded = append(embedded, index)
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			if tag != "" {
+				if _, ok := tagged[tag]; !ok {
+					tagged[tag] = index
+				}
+			} else if name := strings.ToLower(f.Name); untagged[name] == nil {
+				untagged[name] = index
+			}
+		}
+		for _, index := range embedded {
+			walk(t.Field(index[len(index)-1]).Type, index)
+		}
+	}
+	walk(t, nil)
+	for name, index := range tagged {
+		untagged[name] = index
+	}
+	return untagged
+}
@@ -0,0 +1,107 @@
+package linq
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	. "fmt"
+	"io"
+	"strings"
+)
+
+// tableDriver is a database/sql driver whose query is a comma-separated
+// list of column names followed by the rows, e.g.
+// "id,name;1,alice;2,bob".
+type tableDriver struct{}
+
+var errTableUnsupported = errors.New("not supported")
+
+func (tableDriver) Open(string) (driver.Conn, error) { return tableConn{}, nil }
+
+type tableConn struct{}
+
+func (tableConn) Prepare(query string) (driver.Stmt, error) {
+	return tableStmt(query), nil
+}
+func (tableConn) Close() error              { return nil }
+func (tableConn) Begin() (driver.Tx, error) { return nil, errTableUnsupported }
+
+type tableStmt string
+
+func (tableStmt) Close() error  { return nil }
+func (tableStmt) NumInput() int { return 0 }
+func (tableStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errTableUnsupported
+}
+func (s tableStmt) Query([]driver.Value) (driver.Rows, error) {
+	lines := strings.Split(string(s), ";")
+	return &tableRows{strings.Split(lines[0], ","), lines[1:]}, nil
+}
+
+type tableRows struct {
+	columns []string
+	lines   []string
+}
+
+func (r *tableRows) Columns() []string { return r.columns }
+func (r *tableRows) Close() error      { return nil }
+func (r *tableRows) Next(dest []driver.Value) error {
+	if len(r.lines) == 0 {
+		return io.EOF
+	}
+	for i, v := range strings.Split(r.lines[0], ",") {
+		dest[i] = v
+	}
+	r.lines = r.lines[1:]
+	return nil
+}
+
+var tableDB *sql.DB
+
+func init() {
+	sql.Register("linqtable", tableDriver{})
+	tableDB, _ = sql.Open("linqtable", "")
+}
+
+func ExampleFromRows() {
+	rows, err := tableDB.Query("id,name;1,alice;2,bob;3,carol")
+	if err != nil {
+		Println(err)
+		return
+	}
+	x := FromRows(func(rows *sql.Rows) (string, error) {
+		var id int
+		var name string
+		err := rows.Scan(&id, &name)
+		return Sprint(name, "#", id), err
+	}, rows)
+	Println(x.Take(2).ToSlice())
+	// Output: [alice#1 bob#2]
+}
+
+type sqlAudit struct {
+	Created string `db:"created_at"`
+}
+
+type sqlUser struct {
+	ID     int    `db:"user_id"`
+	Name   string // matched ignoring case
+	Secret string `db:"-"`
+	sqlAudit
+}
+
+func ExampleFromRowsStruct() {
+	rows, _ := tableDB.Query("user_id,NAME,secret,created_at,extra;" +
+		"1,alice,x,2024-01-02,y;2,bob,z,2024-03-04,w")
+	x := FromRowsStruct[sqlUser](rows)
+	x(func(u sqlUser) {
+		Printf("%+v\n", u)
+	})
+
+	y := FromRowsStruct[int](rows)
+	Println(Materialize(y).ToSlice())
+	// Output:
+	// {ID:1 Name:alice Secret: sqlAudit:{Created:2024-01-02}}
+	// {ID:2 Name:bob Secret: sqlAudit:{Created:2024-03-04}}
+	// [OnError(linq: FromRowsStruct: int is not a struct)]
+}
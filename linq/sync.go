@@ -0,0 +1,66 @@
+package linq
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// An Enumerator may be enumerated by several goroutines concurrently only
+// if its source and the functions given to its operators are safe for
+// concurrent use.
+// Slices, ranges and Replayable are safe; stateful sources such as
+// FromChan, FromReader and FromScanner are not, and neither are most
+// closures which capture variables.
+// Synchronized and Exclusive make the contract explicit.
+
+// ErrConcurrentEnumeration is the error with which an Enumerator created by
+// Exclusive panics when it is enumerated concurrently.
+var ErrConcurrentEnumeration = errors.New(
+	"linq: sequence enumerated concurrently")
+
+// Synchronized creates an Enumerator which serializes the enumerations of
+// loop with a mutex, so that a source which is not safe for concurrent
+// use can be shared among goroutines.
+// Each enumeration holds the mutex until it completes or terminates, so
+// enumerating the result again within yield deadlocks.
+func (loop Enumerator[T]) Synchronized() Enumerator[T] {
+	var mu sync.Mutex
+	return func(yield func(T)) {
+		mu.Lock()
+		defer mu.Unlock()
+		loop(yield)
+	}
+}
+
+// Exclusive creates an Enumerator which panics with
+// ErrConcurrentEnumeration if it is enumerated while another enumeration
+// of it is in progress, to detect a violation of the contract above.
+// Sequential enumerations are allowed.
+func (loop Enumerator[T]) Exclusive() Enumerator[T] {
+	var busy int32
+	return func(yield func(T)) {
+		if !atomic.CompareAndSwapInt32(&busy, 0, 1) {
+			panic(ErrConcurrentEnumeration)
+		}
+		defer atomic.StoreInt32(&busy, 0)
+		loop(yield)
+	}
+}
+
+// ToSyncMap creates a sync.Map which maps keySelector(element) to
+// valueSelector(element) for each element of loop.
+// If several elements have the same key, the last one wins.
+// It is safe even if loop yields its elements from several goroutines
+// concurrently, so that the results of a parallel pipeline can be
+// collected without locking by the caller.
+// A sharded map is not provided, since it was not measured to be faster
+// than one sync.Map.
+func ToSyncMap[T any, K comparable, V any](keySelector func(T) K,
+	valueSelector func(T) V, loop Enumerator[T]) *sync.Map {
+	result := new(sync.Map)
+	loop(func(element T) {
+		result.Store(keySelector(element), valueSelector(element))
+	})
+	return result
+}
@@ -0,0 +1,75 @@
+package linq
+
+import (
+	. "fmt"
+	"sync"
+)
+
+// concurrently yields the elements of loop from n goroutines.
+func concurrently[T any](n int, loop Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		var wg sync.WaitGroup
+		ch := make(chan T)
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for element := range ch {
+					yield(element)
+				}
+			}()
+		}
+		loop(func(element T) {
+			ch <- element
+		})
+		close(ch)
+		wg.Wait()
+	}
+}
+
+func ExampleToSyncMap() {
+	m := ToSyncMap(func(i int) int { return i }, func(i int) int {
+		return i * i
+	}, concurrently(4, Range(1, 100)))
+	v, ok := m.Load(12)
+	Println(v, ok)
+	// Output:
+	// 144 true
+}
+
+func ExampleEnumerator_Synchronized() {
+	// A stateful source which is not safe for concurrent use
+	next := 0
+	counter := Enumerator[int](func(yield func(int)) {
+		for i := 0; i < 1000; i++ {
+			next++
+			yield(next)
+		}
+	}).Synchronized()
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			counter.Count()
+		}()
+	}
+	wg.Wait()
+	Println(next)
+	// Output:
+	// 4000
+}
+
+func ExampleEnumerator_Exclusive() {
+	x := Range(1, 3).Exclusive()
+	Println(x.ToSlice(), x.ToSlice())
+
+	// An enumeration within another one
+	nested := Materialize(SelectMany(func(int) Enumerator[int] {
+		return x
+	}, x))
+	Println(nested.Last())
+	// Output:
+	// [1 2 3] [1 2 3]
+	// Some(OnError(linq: sequence enumerated concurrently))
+}
@@ -0,0 +1,107 @@
+package linq
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// SplitString creates an Enumerator[string] which yields the substrings of
+// s separated by sep lazily, in the same way as strings.Split.
+// If sep is empty, it yields each UTF-8 sequence of s.
+func SplitString(s, sep string) Enumerator[string] {
+	return func(yield func(string)) {
+		if sep == "" {
+			for x := s; x != ""; {
+				_, size := utf8.DecodeRuneInString(x)
+				yield(x[:size])
+				x = x[size:]
+			}
+			return
+		}
+		x := s
+		for {
+			i := strings.Index(x, sep)
+			if i < 0 {
+				yield(x)
+				return
+			}
+			yield(x[:i])
+			x = x[i+len(sep):]
+		}
+	}
+}
+
+// SplitAny creates an Enumerator[string] which yields the substrings of
+// s separated by any of the Unicode code points in chars lazily.
+// Adjacent separators produce empty substrings as in SplitString.
+func SplitAny(s, chars string) Enumerator[string] {
+	return func(yield func(string)) {
+		x := s
+		for {
+			i := strings.IndexAny(x, chars)
+			if i < 0 {
+				yield(x)
+				return
+			}
+			yield(x[:i])
+			_, size := utf8.DecodeRuneInString(x[i:])
+			x = x[i+size:]
+		}
+	}
+}
+
+// Fields creates an Enumerator[string] which yields the substrings of s
+// around each run of white space lazily, in the same way as strings.Fields.
+func Fields(s string) Enumerator[string] {
+	return FieldsFunc(s, unicode.IsSpace)
+}
+
+// FieldsFunc creates an Enumerator[string] which yields the substrings of
+// s around each run of code points c satisfying f(c) lazily, in the same
+// way as strings.FieldsFunc.
+func FieldsFunc(s string, f func(rune) bool) Enumerator[string] {
+	return func(yield func(string)) {
+		start := -1 // the start of the current field, or -1
+		for i, c := range s {
+			if f(c) {
+				if start >= 0 {
+					yield(s[start:i])
+					start = -1
+				}
+			} else if start < 0 {
+				start = i
+			}
+		}
+		if start >= 0 {
+			yield(s[start:])
+		}
+	}
+}
+
+// ConcatStrings concatenates the strings of loop.
+// It buffers the strings and grows the result to the total length at
+// once, so that it takes linear time, unlike Aggregate with +=.
+func ConcatStrings(loop Enumerator[string]) string {
+	var b strings.Builder
+	buffered(loop, func(x []string) {
+		n := 0
+		for _, s := range x {
+			n += len(s)
+		}
+		b.Grow(n)
+		for _, s := range x {
+			b.WriteString(s)
+		}
+	})
+	return b.String()
+}
+
+// ToBuilder writes the strings of loop to b as they are yielded.
+// Unlike ConcatStrings, it buffers nothing but b; call b.Grow beforehand
+// if the total length is known.
+func ToBuilder(b *strings.Builder, loop Enumerator[string]) {
+	loop(func(s string) {
+		b.WriteString(s)
+	})
+}
@@ -0,0 +1,79 @@
+package linq
+
+import (
+	. "fmt"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func ExampleSplitString() {
+	x := SplitString("a,b,,c", ",")
+	Printf("%q\n", x.ToSlice())
+
+	// Only the first two fields are split out.
+	y := SplitString("2022-03-25T12:00:00", "-").Take(2)
+	Printf("%q\n", y.ToSlice())
+
+	z := SplitString("日本", "")
+	Printf("%q\n", z.ToSlice())
+	// Output:
+	// ["a" "b" "" "c"]
+	// ["2022" "03"]
+	// ["日" "本"]
+}
+
+func ExampleSplitAny() {
+	x := SplitAny("a,b;c,,d", ",;")
+	Printf("%q\n", x.ToSlice())
+	// Output:
+	// ["a" "b" "c" "" "d"]
+}
+
+func ExampleFields() {
+	x := Fields("  A quick\tbrown\n fox ")
+	Printf("%q\n", x.ToSlice())
+	// Output:
+	// ["A" "quick" "brown" "fox"]
+}
+
+func ExampleFieldsFunc() {
+	x := FieldsFunc("foo1;bar2,baz3...", func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsNumber(c)
+	})
+	Printf("%q\n", x.ToSlice())
+	// Output:
+	// ["foo1" "bar2" "baz3"]
+}
+
+func ExampleConcatStrings() {
+	x := Select(func(i int) string { return Sprint(i) }, Range(1, 12))
+	Println(ConcatStrings(x))
+	// Output:
+	// 123456789101112
+}
+
+func ExampleToBuilder() {
+	var b strings.Builder
+	b.WriteString("words:")
+	ToBuilder(&b, Fields("a quick brown fox"))
+	Println(b.String())
+	// Output:
+	// words:aquickbrownfox
+}
+
+func BenchmarkConcatStrings(b *testing.B) {
+	loop := Repeat("abcdefgh", 1000)
+	b.Run("ConcatStrings", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ConcatStrings(loop)
+		}
+	})
+	b.Run("Aggregate", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			Aggregate(func(acc, s string) string { return acc + s }, "", loop)
+		}
+	})
+}
@@ -0,0 +1,176 @@
+package linq
+
+import (
+	"container/heap"
+	"time"
+)
+
+// TumblingWindow creates an Enumerator which groups the elements of loop
+// into consecutive time buckets of length d by their timestamps ts, e.g.
+// per minute for metrics aggregation.
+// The buckets are aligned to multiples of d since the zero time, as by
+// time.Time.Truncate, and the empty ones are skipped.
+// loop must be ordered by ts; see ReorderBy.
+// Each window is yielded as soon as an element of a later bucket arrives.
+func TumblingWindow[T any](d time.Duration, ts func(T) time.Time,
+	loop Enumerator[T]) Enumerator[[]T] {
+	return func(yield func([]T)) {
+		var window []T
+		var bucket time.Time
+		loop(func(element T) {
+			b := ts(element).Truncate(d)
+			if window != nil && !b.Equal(bucket) {
+				yield(window)
+				window = nil
+			}
+			bucket = b
+			window = append(window, element)
+		})
+		if window != nil {
+			yield(window)
+		}
+	}
+}
+
+// SlidingWindow creates an Enumerator which groups the elements of loop
+// into time windows of length size which start at every step, by their
+// timestamps ts; e.g. the last 5 minutes at every minute.
+// An element belongs to every window which contains its timestamp.
+// The windows are aligned to multiples of step since the zero time, and
+// the empty ones are skipped.
+// loop must be ordered by ts; see ReorderBy.
+// Each window is yielded as soon as an element after its end arrives.
+// Each window is a newly allocated slice.
+func SlidingWindow[T any](size, step time.Duration, ts func(T) time.Time,
+	loop Enumerator[T]) Enumerator[[]T] {
+	return func(yield func([]T)) {
+		if size <= 0 || step <= 0 {
+			return
+		}
+		var buf []T           // the elements of the windows not yielded yet
+		var times []time.Time // the timestamps of buf
+		var next time.Time    // the start of the next window
+		// first returns the start of the first window containing t.
+		first := func(t time.Time) time.Time {
+			return t.Add(-size).Truncate(step).Add(step)
+		}
+		// emit yields the next window and advances to the window after it.
+		emit := func() {
+			end := next.Add(size)
+			var window []T
+			for i, t := range times {
+				if !t.Before(end) {
+					break
+				}
+				if !t.Before(next) {
+					window = append(window, buf[i])
+				}
+			}
+			if window != nil {
+				yield(window)
+			}
+			next = next.Add(step)
+			i := 0
+			for i < len(times) && times[i].Before(next) {
+				i++
+			}
+			buf, times = buf[i:], times[i:]
+		}
+		loop(func(element T) {
+			t := ts(element)
+			if len(buf) == 0 {
+				next = first(t)
+			}
+			for len(buf) != 0 && !t.Before(next.Add(size)) {
+				emit()
+			}
+			if len(buf) == 0 {
+				next = first(t)
+			}
+			buf = append(buf, element)
+			times = append(times, t)
+		})
+		for len(buf) != 0 {
+			emit()
+		}
+	}
+}
+
+// SessionWindow creates an Enumerator which groups the elements of loop
+// into sessions by their timestamps ts; a session closes when the next
+// element arrives more than gap after the last one, e.g. for clickstream
+// sessionization.
+// loop must be ordered by ts; see ReorderBy.
+// Each session is yielded as soon as an element of the next session
+// arrives.
+func SessionWindow[T any](gap time.Duration, ts func(T) time.Time,
+	loop Enumerator[T]) Enumerator[[]T] {
+	return func(yield func([]T)) {
+		var session []T
+		var last time.Time
+		loop(func(element T) {
+			t := ts(element)
+			if session != nil && t.Sub(last) > gap {
+				yield(session)
+				session = nil
+			}
+			last = t
+			session = append(session, element)
+		})
+		if session != nil {
+			yield(session)
+		}
+	}
+}
+
+// ReorderBy creates an Enumerator which buffers the elements of loop and
+// yields them in the order of their timestamps ts, so that the window
+// operators above see ordered events.
+// The watermark is the latest timestamp so far minus allowedLateness.
+// An element is yielded once the watermark has passed its timestamp, and
+// an element which arrives with a timestamp before the watermark is
+// dropped as too late.
+// The elements with the same timestamp keep their order in loop.
+// The rest of the buffer is yielded at the end of loop.
+func ReorderBy[T any](ts func(T) time.Time, allowedLateness time.Duration,
+	loop Enumerator[T]) Enumerator[T] {
+	return ReorderByLate(ts, allowedLateness, func(T) {}, loop)
+}
+
+// ReorderByLate is a variant of ReorderBy.
+// It calls late for each element which is too late, instead of dropping
+// it silently.
+func ReorderByLate[T any](ts func(T) time.Time,
+	allowedLateness time.Duration, late func(T),
+	loop Enumerator[T]) Enumerator[T] {
+	type item struct {
+		t   time.Time
+		seq int // the arrival order to break ties
+		e   T
+	}
+	return func(yield func(T)) {
+		h := &Heap[item]{less: func(a, b item) bool {
+			return a.t.Before(b.t) || a.t.Equal(b.t) && a.seq < b.seq
+		}}
+		var watermark time.Time
+		seq := 0
+		loop(func(element T) {
+			t := ts(element)
+			if seq > 0 && t.Before(watermark) {
+				late(element)
+				return
+			}
+			if w := t.Add(-allowedLateness); seq == 0 || w.After(watermark) {
+				watermark = w
+			}
+			heap.Push(h, item{t, seq, element})
+			seq++
+			for h.Len() > 0 && !h.Elements[0].t.After(watermark) {
+				yield(heap.Pop(h).(item).e)
+			}
+		})
+		for h.Len() > 0 {
+			yield(heap.Pop(h).(item).e)
+		}
+	}
+}
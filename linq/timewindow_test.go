@@ -0,0 +1,64 @@
+package linq
+
+import (
+	. "fmt"
+	"time"
+)
+
+type event struct {
+	At   time.Time
+	Name string
+}
+
+func eventTime(e event) time.Time { return e.At }
+
+// events creates events named "a", "b", "c", ... at the given seconds.
+func events(seconds ...int) Enumerator[event] {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return func(yield func(event)) {
+		for i, s := range seconds {
+			yield(event{base.Add(time.Duration(s) * time.Second),
+				string(rune('a' + i))})
+		}
+	}
+}
+
+func names(window []event) []string {
+	return Select(func(e event) string { return e.Name }, From(window)).
+		ToSlice()
+}
+
+func ExampleTumblingWindow() {
+	x := TumblingWindow(10*time.Second, eventTime, events(1, 5, 12, 19, 40))
+	Println(Select(names, x).ToSlice())
+	// Output:
+	// [[a b] [c d] [e]]
+}
+
+func ExampleSlidingWindow() {
+	// windows of 10 seconds at every 5 seconds
+	x := SlidingWindow(10*time.Second, 5*time.Second, eventTime,
+		events(1, 6, 12, 40))
+	Println(Select(names, x).ToSlice())
+	// Output:
+	// [[a] [a b] [b c] [c] [d] [d]]
+}
+
+func ExampleSessionWindow() {
+	x := SessionWindow(30*time.Second, eventTime,
+		events(0, 20, 45, 100, 110, 200))
+	Println(Select(names, x).ToSlice())
+	// Output:
+	// [[a b c] [d e] [f]]
+}
+
+func ExampleReorderBy() {
+	// "f" at 3 seconds arrives after the watermark reaches 10 - 5 seconds.
+	x := ReorderByLate(eventTime, 5*time.Second, func(e event) {
+		Println("late:", e.Name)
+	}, events(2, 1, 6, 4, 10, 3, 8, 20))
+	Println(names(x.ToSlice()))
+	// Output:
+	// late: f
+	// [b a d c g e h]
+}
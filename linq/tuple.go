@@ -0,0 +1,55 @@
+package linq
+
+// Pair is a tuple of two values, e.g. an index and an element, or a key
+// and a value.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// MakePair creates a Pair of a and b.
+func MakePair[A any, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{a, b}
+}
+
+// Unpack returns the values of p.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Triple is a tuple of three values.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// MakeTriple creates a Triple of a, b and c.
+func MakeTriple[A any, B any, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{a, b, c}
+}
+
+// Unpack returns the values of t.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// ZipToPairs creates an Enumerator which enumerates loop1 and loop2 in step
+// and yields each element pair as a Pair.
+// It stops when either of them runs out, as Zip does.
+func ZipToPairs[T any, U any](loop1 Enumerator[T],
+	loop2 Enumerator[U]) Enumerator[Pair[T, U]] {
+	return Zip(MakePair[T, U], loop1, loop2)
+}
+
+// Enumerate creates an Enumerator which pairs each element of loop with its
+// index, counting from 0.
+func Enumerate[T any](loop Enumerator[T]) Enumerator[Pair[int, T]] {
+	return func(yield func(Pair[int, T])) {
+		i := 0
+		loop(func(element T) {
+			yield(Pair[int, T]{i, element})
+			i++
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package linq
+
+import (
+	. "fmt"
+)
+
+func ExamplePair() {
+	p := MakePair("answer", 42)
+	k, v := p.Unpack()
+	Println(p, k, v)
+
+	t := MakeTriple(1, "two", 3.0)
+	a, b, c := t.Unpack()
+	Println(t, a, b, c)
+	// Output:
+	// {answer 42} answer 42
+	// {1 two 3} 1 two 3
+}
+
+func ExampleZipToPairs() {
+	x := ZipToPairs(From([]string{"a", "b", "c"}), IntsFrom(1))
+	Println(x.ToSlice())
+	// Output:
+	// [{a 1} {b 2} {c 3}]
+}
+
+func ExampleEnumerate() {
+	Enumerate(From([]string{"zero", "one", "two"}))(func(p Pair[int, string]) {
+		i, s := p.Unpack()
+		Println(i, s)
+	})
+	// Output:
+	// 0 zero
+	// 1 one
+	// 2 two
+}
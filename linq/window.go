@@ -0,0 +1,142 @@
+package linq
+
+// Chunk creates an Enumerator which splits loop into slices of size
+// elements.
+// The last slice may be shorter than size.
+func Chunk[T any](size int, loop Enumerator[T]) Enumerator[[]T] {
+	return WindowStep(size, size, true, loop)
+}
+
+// WindowStep creates an Enumerator which yields windows of size elements
+// which start at every step elements of loop; e.g. for frame extraction
+// with size 256 and hop 128.
+// If step is greater than size, the elements between windows are skipped.
+// If partial is true, the windows which started but were not filled up at
+// the end of loop are also yielded.
+// Each window is a newly allocated slice.
+// It yields nothing if size or step is less than 1.
+func WindowStep[T any](size, step int, partial bool,
+	loop Enumerator[T]) Enumerator[[]T] {
+	return func(yield func([]T)) {
+		if size < 1 || step < 1 {
+			return
+		}
+		// buf holds the elements in order until size elements arrive, so
+		// that a large size with a short loop allocates little; then ring
+		// is a ring buffer as in Rolling, where ring[i : i+size] holds the
+		// last size elements in order.
+		var buf, ring []T
+		i := 0
+		n := 0 // the number of elements so far
+		last := func() []T {
+			if ring == nil {
+				return buf
+			}
+			return ring[i : i+size]
+		}
+		loop(func(element T) {
+			if ring == nil {
+				buf = append(buf, element)
+				if len(buf) == size {
+					ring = make([]T, 2*size)
+					copy(ring, buf)
+					copy(ring[size:], buf)
+					buf = nil
+				}
+			} else {
+				ring[i] = element
+				ring[i+size] = element
+				i = (i + 1) % size
+			}
+			n++
+			if start := n - size; start >= 0 && start%step == 0 {
+				window := make([]T, size)
+				copy(window, last())
+				yield(window)
+			}
+		})
+		if partial {
+			buf := last()         // the last elements
+			first := n - len(buf) // the index of buf[0]
+			for start := (first / step) * step; start < n; start += step {
+				if start > n-size && start >= first {
+					window := make([]T, n-start)
+					copy(window, buf[start-first:])
+					yield(window)
+				}
+			}
+		}
+	}
+}
+
+// Rolling creates an Enumerator which yields agg(window) for each window
+// of the last size elements of loop, once size elements have arrived; e.g.
+// a moving median over a time series.
+// The window is a view of an internal ring buffer, which is valid only
+// during the call of agg; agg must copy it to keep it.
+// Each element is copied twice at most, whatever size is.
+// It yields nothing if size is less than 1.
+func Rolling[T any, R any](size int, agg func([]T) R,
+	loop Enumerator[T]) Enumerator[R] {
+	return func(yield func(R)) {
+		if size < 1 {
+			return
+		}
+		// Each element is put at both i and i + size so that
+		// buf[i+1 : i+1+size] holds the last size elements in order.
+		buf := make([]T, 2*size)
+		i := 0
+		n := 0
+		loop(func(element T) {
+			buf[i] = element
+			buf[i+size] = element
+			i = (i + 1) % size
+			if n < size {
+				n++
+			}
+			if n == size {
+				yield(agg(buf[i : i+size]))
+			}
+		})
+	}
+}
+
+// RollingSum creates an Enumerator which yields the sum of each window of
+// the last size elements of loop, once size elements have arrived.
+// It updates the sum by the entering and leaving elements in O(1), so
+// rounding errors may accumulate for floating-point elements.
+func RollingSum[T Number](size int, loop Enumerator[T]) Enumerator[T] {
+	return func(yield func(T)) {
+		if size < 1 {
+			return
+		}
+		ring := make([]T, 0, size)
+		i := 0 // the index of the oldest element if the ring is full
+		var sum T
+		loop(func(element T) {
+			sum += element
+			if len(ring) < size {
+				ring = append(ring, element)
+			} else {
+				sum -= ring[i]
+				ring[i] = element
+				i = (i + 1) % size
+			}
+			if len(ring) == size {
+				yield(sum)
+			}
+		})
+	}
+}
+
+// RollingAverage creates an Enumerator which yields the average of each
+// window of the last size elements of loop, once size elements have
+// arrived; i.e. a simple moving average.
+func RollingAverage[T Number](size int,
+	loop Enumerator[T]) Enumerator[float64] {
+	return Select(func(sum float64) float64 {
+		return sum / float64(size)
+	}, RollingSum(size, Select(func(x T) float64 {
+		return float64(x)
+	}, loop)))
+}
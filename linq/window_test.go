@@ -0,0 +1,69 @@
+package linq
+
+import (
+	. "fmt"
+	"testing"
+)
+
+func ExampleChunk() {
+	x := Chunk(3, Range(1, 8))
+	Printf("%v\n", x.ToSlice())
+
+	// The buffer grows with the elements, not with size.
+	y := Chunk(1<<40, From([]int{1, 2, 3}))
+	Printf("%v\n", y.ToSlice())
+	// Output:
+	// [[1 2 3] [4 5 6] [7 8]]
+	// [[1 2 3]]
+}
+
+func ExampleWindowStep() {
+	x := WindowStep(4, 2, false, Range(1, 9))
+	Printf("%v\n", x.ToSlice())
+
+	y := WindowStep(4, 2, true, Range(1, 9))
+	Printf("%v\n", y.ToSlice())
+
+	z := WindowStep(2, 3, true, Range(1, 8))
+	Printf("%v\n", z.ToSlice())
+
+	// The sequence is shorter than a window.
+	w := WindowStep(5, 2, true, Range(1, 3))
+	Printf("%v\n", w.ToSlice())
+	// Output:
+	// [[1 2 3 4] [3 4 5 6] [5 6 7 8]]
+	// [[1 2 3 4] [3 4 5 6] [5 6 7 8] [7 8 9] [9]]
+	// [[1 2] [4 5] [7 8]]
+	// [[1 2 3] [3]]
+}
+
+func ExampleRolling() {
+	// the moving maximum of the last three elements
+	x := Rolling(3, func(w []int) int {
+		m, _ := Max(From(w))
+		return m
+	}, From([]int{1, 3, 2, 5, 4, 1, 0, 2}))
+	Printf("%v\n", x.ToSlice())
+	// Output:
+	// [3 5 5 5 4 2]
+}
+
+func ExampleRollingSum() {
+	Printf("%v\n", RollingSum(3, Range(1, 6)).ToSlice())
+	Printf("%v\n", RollingAverage(4, Range(1, 6)).ToSlice())
+	// Output:
+	// [6 9 12 15]
+	// [2.5 3.5 4.5]
+}
+
+func BenchmarkWindowStep(b *testing.B) {
+	// Each element should cost O(1) besides copying the windows.
+	loop := Range(1, 10000)
+	for _, size := range []int{16, 1024} {
+		b.Run(Sprint("size=", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				WindowStep(size, size, false, loop)(func([]int) {})
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package linq
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// FromXMLTokens creates an Enumerator[xml.Token] which yields each token of
+// the XML document read from r.
+// Each token is copied by xml.CopyToken so that the caller may keep it.
+// Since r is consumed, the enumerator yields nothing when it is enumerated
+// again.
+// The enumerator may panic with the error from the decoder other than
+// io.EOF.
+func FromXMLTokens(r io.Reader) Enumerator[xml.Token] {
+	return func(yield func(xml.Token)) {
+		d := xml.NewDecoder(r)
+		for {
+			token, err := d.Token()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				panic(err)
+			}
+			yield(xml.CopyToken(token))
+		}
+	}
+}
+
+// FromXMLElements creates an Enumerator[T] which decodes each element
+// named localName in the XML document read from r into T.
+// It reads r lazily, holding one element at a time, so that it can process
+// huge documents.
+// Elements nested in a matching element are decoded with it, not yielded
+// separately.
+// The enumerator may panic with the error from the decoder other than
+// io.EOF.
+func FromXMLElements[T any](r io.Reader, localName string) Enumerator[T] {
+	return func(yield func(T)) {
+		d := xml.NewDecoder(r)
+		for {
+			token, err := d.Token()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				panic(err)
+			}
+			if start, ok := token.(xml.StartElement); ok &&
+				start.Name.Local == localName {
+				var element T
+				if err := d.DecodeElement(&element, &start); err != nil {
+					panic(err)
+				}
+				yield(element)
+			}
+		}
+	}
+}
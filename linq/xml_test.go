@@ -0,0 +1,45 @@
+package linq
+
+import (
+	"encoding/xml"
+	. "fmt"
+	"strings"
+)
+
+const booksXML = `<catalog>
+  <book id="1"><title>Go</title><price>30</price></book>
+  <magazine><title>Weekly</title></magazine>
+  <book id="2"><title>LINQ</title><price>45</price></book>
+</catalog>`
+
+func ExampleFromXMLTokens() {
+	tokens := FromXMLTokens(strings.NewReader(booksXML))
+	starts := 0
+	tokens.ForEach(func(t xml.Token) {
+		if _, ok := t.(xml.StartElement); ok {
+			starts++
+		}
+	})
+	Println(starts)
+	// Output:
+	// 9
+}
+
+func ExampleFromXMLElements() {
+	type Book struct {
+		ID    int    `xml:"id,attr"`
+		Title string `xml:"title"`
+		Price int    `xml:"price"`
+	}
+	books := FromXMLElements[Book](strings.NewReader(booksXML), "book")
+	// The reader is consumed; buffer the books to enumerate them twice.
+	x := From(books.ToSlice())
+	x.ForEach(func(b Book) {
+		Println(b.ID, b.Title, b.Price)
+	})
+	Println(Sum(Select(func(b Book) int { return b.Price }, x)))
+	// Output:
+	// 1 Go 30
+	// 2 LINQ 45
+	// 75
+}